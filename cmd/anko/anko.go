@@ -9,8 +9,12 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"github.com/alaingilbert/anko/pkg/ast"
+	"github.com/alaingilbert/anko/pkg/ast/obfuscate"
+	"github.com/alaingilbert/anko/pkg/ast/optimize"
 	"github.com/alaingilbert/anko/pkg/compiler"
 	"github.com/alaingilbert/anko/pkg/decompiler"
+	"github.com/alaingilbert/anko/pkg/format"
 	"github.com/alaingilbert/anko/pkg/parser"
 	"github.com/alaingilbert/anko/pkg/utils"
 	"github.com/alaingilbert/anko/pkg/utils/pubsub"
@@ -43,13 +47,25 @@ type AppFlags struct {
 	FlagExecute string
 	File        string
 	Compile     bool
+	Bundle      bool
 	Decompile   bool
+	Disassemble bool
 	Web         bool
+	Optimize    bool
+	Obfuscate   bool
+	StripPos    bool
+	Comments    bool
 }
 
 func main() {
 	var exitCode int
 	var appFlags AppFlags
+	if len(os.Args) > 1 && os.Args[1] == "fmt" {
+		os.Exit(runFmt(os.Stdout, os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "check" {
+		os.Exit(runCheck(os.Stdout, os.Args[2:]))
+	}
 	args := parseFlags(&appFlags)
 	if appFlags.Decompile {
 		sourceBytes, err := os.ReadFile(appFlags.File)
@@ -59,9 +75,17 @@ func main() {
 		}
 		stmt := compiler.Decode(sourceBytes)
 
-		fmt.Println(decompiler.Decompile(stmt))
+		source, err := decompiler.Decompile(stmt)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(DecompileErrExitCode)
+		}
+		fmt.Print(source)
 		os.Exit(OkExitCode)
 	}
+	if appFlags.Disassemble {
+		os.Exit(runDisassemble(os.Stdout, appFlags.File))
+	}
 	if appFlags.FlagExecute != "" || flag.NArg() > 0 {
 		exitCode = runNonInteractive(args, appFlags)
 	} else if appFlags.Web {
@@ -76,8 +100,14 @@ func parseFlags(appFlags *AppFlags) (args []string) {
 	flagVersion := flag.Bool("v", false, "prints out the version and then exits")
 	flag.StringVar(&appFlags.FlagExecute, "e", "", "execute the Anko code")
 	flag.BoolVar(&appFlags.Compile, "c", false, "compile a script")
+	flag.BoolVar(&appFlags.Bundle, "bundle", false, "compile a script and the files it loads into a single bundle")
 	flag.BoolVar(&appFlags.Decompile, "d", false, "decompile anko bytecode")
+	flag.BoolVar(&appFlags.Disassemble, "disasm", false, "print the raw structure of anko bytecode")
 	flag.BoolVar(&appFlags.Web, "w", false, "web server")
+	flag.BoolVar(&appFlags.Optimize, "O", false, "optimize the script before running or compiling it")
+	flag.BoolVar(&appFlags.Obfuscate, "obfuscate", false, "rename the identifiers defined by the script when compiling it")
+	flag.BoolVar(&appFlags.StripPos, "strip", false, "remove the source positions from the compiled script")
+	flag.BoolVar(&appFlags.Comments, "comments", false, "keep the comments in the compiled script")
 	flag.Parse()
 
 	if *flagVersion {
@@ -96,11 +126,15 @@ func parseFlags(appFlags *AppFlags) (args []string) {
 }
 
 const (
-	OkExitCode          = 0
-	ReadFileErrExitCode = 2
-	ExecuteErrExitCode  = 4
-	CompileErrExitCode  = 5
-	ScannerErrExitCode  = 12
+	OkExitCode             = 0
+	ReadFileErrExitCode    = 2
+	ExecuteErrExitCode     = 4
+	CompileErrExitCode     = 5
+	DisassembleErrExitCode = 6
+	DecompileErrExitCode   = 7
+	FormatErrExitCode      = 8
+	CheckErrExitCode       = 9
+	ScannerErrExitCode     = 12
 )
 
 func runNonInteractive(args []string, appFlags AppFlags) int {
@@ -116,7 +150,11 @@ func runNonInteractive(args []string, appFlags AppFlags) int {
 		source = string(sourceBytes)
 
 		if appFlags.Compile {
-			if err := compileAndSave(source, appFlags.File); err != nil {
+			compileFn := compileAndSave
+			if appFlags.Bundle {
+				compileFn = compileBundleAndSave
+			}
+			if err := compileFn(source, appFlags.File, appFlags); err != nil {
 				handleErr(os.Stdout, err)
 				return CompileErrExitCode
 			}
@@ -127,13 +165,20 @@ func runNonInteractive(args []string, appFlags AppFlags) int {
 	v := vm.New(&vm.Config{
 		ImportCore:   utils.Ptr(true),
 		DefineImport: utils.Ptr(true),
+		Optimize:     utils.Ptr(appFlags.Optimize),
 	})
 	_ = v.Define("args", args)
 	executorInst := v.Executor(nil)
 	fileExt := filepath.Ext(appFlags.File)
 	var err error
-	if appFlags.FlagExecute != "" || fileExt == ankoFileExt {
+	if appFlags.FlagExecute != "" {
 		_, err = executorInst.Run(nil, source)
+	} else if fileExt == ankoFileExt {
+		// the file name is kept in the positions of the errors and of their stack
+		var stmt ast.Stmt
+		if stmt, err = parser.ParseFile(appFlags.File, source); err == nil {
+			_, err = executorInst.Run(nil, stmt)
+		}
 	} else {
 		_, err = executorInst.Run(nil, []byte(source))
 	}
@@ -288,7 +333,9 @@ func rebuildCompleter(env envPkg.IEnv) {
 		if value.IsValid() {
 			if module, ok := value.Interface().(envPkg.IEnv); ok {
 				module.Values().Each(func(modKey string, _ reflect.Value) {
-					keys = append(keys, key+"."+modKey)
+					if module.IsVisible(modKey) {
+						keys = append(keys, key+"."+modKey)
+					}
 				})
 			}
 		}
@@ -310,24 +357,233 @@ func handleErr(w io.Writer, err error) {
 	var vmErr *runner.Error
 	var parserErr *parser.Error
 	if errors.As(err, &vmErr) {
-		_, _ = fmt.Fprintf(w, "%d:%d %s\n", vmErr.Pos.Line, vmErr.Pos.Column, err)
+		_, _ = fmt.Fprintf(w, "%s%d:%d %s\n", filePrefix(vmErr.Span.File), vmErr.Pos.Line, vmErr.Pos.Column, err)
+		if len(vmErr.Stack) > 1 { // a single frame is the top level of the script, already printed
+			_, _ = io.WriteString(w, vmErr.StackTrace())
+		}
 	} else if errors.As(err, &parserErr) {
-		_, _ = fmt.Fprintf(w, "%d:%d %s\n", parserErr.Pos.Line, parserErr.Pos.Column, err)
+		_, _ = fmt.Fprintf(w, "%s%d:%d %s\n", filePrefix(parserErr.Filename), parserErr.Pos.Line, parserErr.Pos.Column, err)
 	} else {
 		_, _ = fmt.Fprintln(w, err)
 	}
 }
 
-func compileAndSave(source, fileName string) error {
-	fileName = strings.Replace(fileName, ankoFileExt, ankoBytecodeExt, 1)
-	out, err := compiler.Compile(source, false)
+// filePrefix returns the "file:" prefix of an error position, empty when the file is unknown
+func filePrefix(fileName string) string {
+	if fileName == "" {
+		return ""
+	}
+	return fileName + ":"
+}
+
+func runDisassemble(w io.Writer, fileName string) int {
+	sourceBytes, err := os.ReadFile(fileName)
+	if err != nil {
+		_, _ = fmt.Fprintln(w, "ReadFile error:", err)
+		return ReadFileErrExitCode
+	}
+	out, err := compiler.Disassemble(sourceBytes)
+	if err != nil {
+		_, _ = fmt.Fprintln(w, err)
+		return DisassembleErrExitCode
+	}
+	_, _ = io.WriteString(w, out)
+	return OkExitCode
+}
+
+// runFmt formats the given files, "anko fmt [-w] files...".
+// The formatted source is printed, or written back to the files which changed with -w.
+func runFmt(w io.Writer, args []string) int {
+	fs := flag.NewFlagSet("fmt", flag.ContinueOnError)
+	fs.SetOutput(w)
+	write := fs.Bool("w", false, "write the result to the source files instead of stdout")
+	if err := fs.Parse(args); err != nil {
+		return FormatErrExitCode
+	}
+	for _, fileName := range fs.Args() {
+		src, err := os.ReadFile(fileName)
+		if err != nil {
+			_, _ = fmt.Fprintln(w, "ReadFile error:", err)
+			return ReadFileErrExitCode
+		}
+		out, err := format.Source(src)
+		if err != nil {
+			_, _ = fmt.Fprintf(w, "%s: %v\n", fileName, err)
+			return FormatErrExitCode
+		}
+		if !*write {
+			_, _ = w.Write(out)
+			continue
+		}
+		if bytes.Equal(src, out) {
+			continue
+		}
+		if err := os.WriteFile(fileName, out, 0644); err != nil {
+			_, _ = fmt.Fprintln(w, "WriteFile error:", err)
+			return FormatErrExitCode
+		}
+	}
+	return OkExitCode
+}
+
+// runCheck reports every syntax error of the given files, "anko check files...".
+// The files without syntax errors are validated against the env scripts are run with, their warnings are reported too.
+func runCheck(w io.Writer, files []string) int {
+	exitCode := OkExitCode
+	for _, fileName := range files {
+		src, err := os.ReadFile(fileName)
+		if err != nil {
+			_, _ = fmt.Fprintln(w, "ReadFile error:", err)
+			return ReadFileErrExitCode
+		}
+		scanner := new(parser.Scanner)
+		scanner.Init(string(src))
+		scanner.SetFilename(fileName)
+		stmt, errs := parser.ParseRecover(scanner)
+		for _, e := range errs {
+			handleErr(w, e)
+		}
+		if len(errs) > 0 {
+			exitCode = CheckErrExitCode
+			continue
+		}
+		v := vm.New(&vm.Config{ImportCore: utils.Ptr(true), DefineImport: utils.Ptr(true)})
+		_ = v.Define("args", nil)
+		warnings, err := v.Executor(nil).ValidateWarnings(context.Background(), stmt)
+		if err != nil {
+			handleErr(w, err)
+			exitCode = CheckErrExitCode
+		}
+		for _, warning := range warnings {
+			handleErr(w, warning)
+			exitCode = CheckErrExitCode
+		}
+	}
+	return exitCode
+}
+
+func compileAndSave(source, fileName string, appFlags AppFlags) error {
+	stmt, err := parseSource(source, appFlags)
 	if err != nil {
 		return err
 	}
-	if err := os.WriteFile(fileName, out, 0744); err != nil {
+	obfuscateStmts(appFlags, nil, stmt)
+	out, err := encodeStmt(stmt, source, appFlags)
+	if err != nil {
 		return err
 	}
-	return nil
+	fileName = strings.Replace(fileName, ankoFileExt, ankoBytecodeExt, 1)
+	return os.WriteFile(fileName, out, 0744)
+}
+
+// compileBundleAndSave compiles the script and every file it loads or imports, directly or not, into a single bundle.
+// The imported compiled modules are not bundled, they are read from the filesystem when the bundle is run.
+func compileBundleAndSave(source, fileName string, appFlags AppFlags) error {
+	var names, sources []string
+	var stmts, modules []ast.Stmt
+	imported := make(map[string]bool)
+	seen := make(map[string]bool)
+	queue := []string{fileName}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if seen[compiler.ModuleName(name)] {
+			continue
+		}
+		seen[compiler.ModuleName(name)] = true
+		if name != fileName {
+			sourceBytes, err := os.ReadFile(name)
+			if err != nil {
+				return err
+			}
+			source = string(sourceBytes)
+		}
+		stmt, err := parseSource(source, appFlags)
+		if err != nil {
+			var pe *parser.Error
+			if errors.As(err, &pe) {
+				pe.Filename = name
+			}
+			return err
+		}
+		names, sources, stmts = append(names, name), append(sources, source), append(stmts, stmt)
+		if imported[compiler.ModuleName(name)] {
+			modules = append(modules, stmt)
+		}
+		queue = append(queue, compiler.LoadedFiles(stmt)...)
+		for _, path := range compiler.ImportedFiles(stmt) {
+			if filepath.Ext(path) == ankoFileExt {
+				path = importedFileName(name, path)
+				imported[compiler.ModuleName(path)] = true
+				queue = append(queue, path)
+			}
+		}
+	}
+	// The files share the env of the main script, they are obfuscated together to keep their symbols in sync
+	obfuscateStmts(appFlags, modules, stmts...)
+	bundle := compiler.NewBundle(fileName)
+	for i, stmt := range stmts {
+		out, err := encodeStmt(stmt, sources[i], appFlags)
+		if err != nil {
+			return err
+		}
+		bundle.Add(names[i], out)
+	}
+	out, err := compiler.EncodeBundle(bundle)
+	if err != nil {
+		return err
+	}
+	fileName = strings.Replace(fileName, ankoFileExt, ankoBytecodeExt, 1)
+	return os.WriteFile(fileName, out, 0744)
+}
+
+// importedFileName returns the name of a module imported by a script, the path is relative to the script directory
+func importedFileName(fileName, imported string) string {
+	if filepath.IsAbs(imported) {
+		return imported
+	}
+	return filepath.Join(filepath.Dir(fileName), imported)
+}
+
+func encodeStmt(stmt ast.Stmt, source string, appFlags AppFlags) ([]byte, error) {
+	if appFlags.Comments {
+		return compiler.EncodeStmtsWithComments(stmt, source, nil)
+	}
+	return compiler.EncodeStmtsWithSource(stmt, source, nil)
+}
+
+func parseSource(source string, appFlags AppFlags) (ast.Stmt, error) {
+	stmt, err := parser.ParseSrc(source)
+	if err != nil {
+		return nil, err
+	}
+	if appFlags.Optimize {
+		stmt = optimize.Optimize(stmt)
+	}
+	return stmt, nil
+}
+
+// obfuscateStmts renames the identifiers defined by the scripts and/or strips their positions, depending on the flags.
+// The symbols of the scripts imported as modules are kept.
+func obfuscateStmts(appFlags AppFlags, modules []ast.Stmt, stmts ...ast.Stmt) {
+	if appFlags.Obfuscate {
+		obfuscate.ObfuscateAll(stmts, &obfuscate.Config{Preserve: hostNames(), StripPositions: appFlags.StripPos, Modules: modules})
+	} else if appFlags.StripPos {
+		for _, stmt := range stmts {
+			obfuscate.StripPositions(stmt)
+		}
+	}
+}
+
+// hostNames returns the names defined in the env the scripts are run with
+func hostNames() []string {
+	v := vm.New(&vm.Config{ImportCore: utils.Ptr(true), DefineImport: utils.Ptr(true)})
+	_ = v.Define("args", nil)
+	names := make([]string, 0)
+	v.Executor(nil).GetEnv().Values().Each(func(key string, _ reflect.Value) {
+		names = append(names, key)
+	})
+	return names
 }
 
 func runWeb() int {
@@ -73,6 +73,128 @@ func TestRunNonInteractiveExecute(t *testing.T) {
 	flagExecute = ""
 }
 
+func TestRunDisassemble(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "test.ank")
+	assert.NoError(t, os.WriteFile(file, []byte("a = 1"), 0644))
+	assert.Equal(t, OkExitCode, runNonInteractive(nil, AppFlags{File: file, Compile: true}))
+
+	buf := new(bytes.Buffer)
+	assert.Equal(t, OkExitCode, runDisassemble(buf, filepath.Join(dir, "test.bnk")))
+	assert.Contains(t, buf.String(), "LetsStmtBytecode")
+
+	buf.Reset()
+	assert.Equal(t, DisassembleErrExitCode, runDisassemble(buf, file))
+	assert.Equal(t, ReadFileErrExitCode, runDisassemble(buf, filepath.Join(dir, "not-found.bnk")))
+}
+
+func TestRunCompileBundle(t *testing.T) {
+	dir := t.TempDir()
+	libFile := filepath.Join(dir, "lib.ank")
+	subFile := filepath.Join(dir, "sub.ank")
+	mainFile := filepath.Join(dir, "main.ank")
+	assert.NoError(t, os.WriteFile(subFile, []byte(`b = 2`), 0644))
+	assert.NoError(t, os.WriteFile(libFile, []byte(`load("`+subFile+`"); a = 1`), 0644))
+	assert.NoError(t, os.WriteFile(mainFile, []byte(`load("`+libFile+`"); if (a + b) != 3 { throw "failed" }`), 0644))
+	assert.Equal(t, OkExitCode, runNonInteractive(nil, AppFlags{File: mainFile, Compile: true, Bundle: true}))
+
+	// The bundle must not need the loaded files anymore
+	assert.NoError(t, os.Remove(libFile))
+	assert.NoError(t, os.Remove(subFile))
+	assert.Equal(t, OkExitCode, runNonInteractive(nil, AppFlags{File: filepath.Join(dir, "main.bnk")}))
+
+	assert.Equal(t, CompileErrExitCode, runNonInteractive(nil, AppFlags{File: mainFile, Compile: true, Bundle: true}))
+}
+
+func TestRunCompileObfuscate(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "test.ank")
+	assert.NoError(t, os.WriteFile(file, []byte(`func inc(x) { return x + 1 }; if inc(len(args)) != 1 { throw "failed" }`), 0644))
+	assert.Equal(t, OkExitCode, runNonInteractive(nil, AppFlags{File: file, Compile: true, Obfuscate: true, StripPos: true}))
+
+	buf := new(bytes.Buffer)
+	assert.Equal(t, OkExitCode, runDisassemble(buf, filepath.Join(dir, "test.bnk")))
+	assert.NotContains(t, buf.String(), `"inc"`)
+	assert.Contains(t, buf.String(), `"args"`)
+	assert.NotContains(t, buf.String(), "pos 1:")
+	assert.Equal(t, OkExitCode, runNonInteractive(nil, AppFlags{File: filepath.Join(dir, "test.bnk")}))
+}
+
+func TestRunCompileObfuscateModule(t *testing.T) {
+	dir := t.TempDir()
+	libFile := filepath.Join(dir, "lib.ank")
+	assert.NoError(t, os.WriteFile(libFile, []byte(`export func hello() { return greeting }; greeting = "hello"; Name = "lib"`), 0644))
+	assert.Equal(t, OkExitCode, runNonInteractive(nil, AppFlags{File: libFile, Compile: true, Obfuscate: true}))
+	assert.NoError(t, os.Remove(libFile))
+
+	mainFile := filepath.Join(dir, "main.ank")
+	assert.NoError(t, os.WriteFile(mainFile, []byte(`lib = import("./lib.bnk"); if lib.hello() != "hello" || lib.Name != "lib" { throw "failed" }`), 0644))
+	assert.Equal(t, OkExitCode, runNonInteractive(nil, AppFlags{File: mainFile}))
+}
+
+func TestRunCompileComments(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "test.ank")
+	assert.NoError(t, os.WriteFile(file, []byte("# doc\na = 1"), 0644))
+	assert.Equal(t, OkExitCode, runNonInteractive(nil, AppFlags{File: file, Compile: true, Comments: true}))
+
+	buf := new(bytes.Buffer)
+	assert.Equal(t, OkExitCode, runDisassemble(buf, filepath.Join(dir, "test.bnk")))
+	assert.Contains(t, buf.String(), "comments 1 nodes")
+	assert.Equal(t, OkExitCode, runNonInteractive(nil, AppFlags{File: filepath.Join(dir, "test.bnk")}))
+}
+
+func TestRunFmt(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "test.ank")
+	assert.NoError(t, os.WriteFile(file, []byte("# comment\na=1\nif a==1 {\nprintln(a) // print\n}"), 0644))
+	expected := "# comment\na = 1\nif a == 1 {\n    println(a) // print\n}\n"
+
+	buf := new(bytes.Buffer)
+	assert.Equal(t, OkExitCode, runFmt(buf, []string{file}))
+	assert.Equal(t, expected, buf.String())
+
+	buf.Reset()
+	assert.Equal(t, OkExitCode, runFmt(buf, []string{"-w", file}))
+	assert.Empty(t, buf.String())
+	src, err := os.ReadFile(file)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, string(src))
+
+	invalidFile := filepath.Join(dir, "invalid.ank")
+	assert.NoError(t, os.WriteFile(invalidFile, []byte("a ==== 1"), 0644))
+	assert.Equal(t, FormatErrExitCode, runFmt(buf, []string{invalidFile}))
+	assert.Equal(t, ReadFileErrExitCode, runFmt(buf, []string{filepath.Join(dir, "not-found.ank")}))
+}
+
+func TestRunCheck(t *testing.T) {
+	dir := t.TempDir()
+	validFile := filepath.Join(dir, "valid.ank")
+	assert.NoError(t, os.WriteFile(validFile, []byte("a = 1\nprintln(a)"), 0644))
+	syntaxFile := filepath.Join(dir, "syntax.ank")
+	assert.NoError(t, os.WriteFile(syntaxFile, []byte("a = 1\nb = ]\nc = 2\nd = )"), 0644))
+	undefinedFile := filepath.Join(dir, "undefined.ank")
+	assert.NoError(t, os.WriteFile(undefinedFile, []byte("a = 1\nb = invalidFn(a)"), 0644))
+	warningFile := filepath.Join(dir, "warning.ank")
+	assert.NoError(t, os.WriteFile(warningFile, []byte("enum Color { Red, Blue }\nswitch Color.Red { case Color.Red: 1 }"), 0644))
+
+	buf := new(bytes.Buffer)
+	assert.Equal(t, OkExitCode, runCheck(buf, []string{validFile}))
+	assert.Empty(t, buf.String())
+
+	buf.Reset()
+	assert.Equal(t, CheckErrExitCode, runCheck(buf, []string{syntaxFile, validFile, undefinedFile}))
+	assert.Equal(t, syntaxFile+":2:5 unexpected ']'\n"+
+		syntaxFile+":4:5 unexpected ')'\n"+
+		undefinedFile+":2:5 undefined symbol 'invalidFn'\n", buf.String())
+
+	buf.Reset()
+	assert.Equal(t, CheckErrExitCode, runCheck(buf, []string{warningFile}))
+	assert.Equal(t, warningFile+":2:1 switch on enum Color is missing the cases Blue\n", buf.String())
+
+	assert.Equal(t, ReadFileErrExitCode, runCheck(buf, []string{filepath.Join(dir, "not-found.ank")}))
+}
+
 type testInteractive struct {
 	runLines   []string
 	runOutputs []string
@@ -284,4 +406,16 @@ func TestHandleErr(t *testing.T) {
 	buf.Reset()
 	handleErr(buf, &runner.Error{Pos: ast.Position{Line: 3, Column: 4}, Message: "something"})
 	assert.Equal(t, "3:4 something\n", buf.String())
+	buf.Reset()
+	handleErr(buf, &parser.Error{Pos: ast.Position{Line: 1, Column: 2}, Filename: "lib.ank", Message: "something"})
+	assert.Equal(t, "lib.ank:1:2 something\n", buf.String())
+	buf.Reset()
+	handleErr(buf, &runner.Error{Pos: ast.Position{Line: 3, Column: 4}, Span: ast.Span{File: "lib.ank"}, Message: "something"})
+	assert.Equal(t, "lib.ank:3:4 something\n", buf.String())
+	buf.Reset()
+	handleErr(buf, &runner.Error{Pos: ast.Position{Line: 3, Column: 4}, Message: "something", Stack: []runner.Frame{
+		{Func: "f", Pos: ast.Position{Line: 3, Column: 4}, Span: ast.Span{File: "lib.ank"}},
+		{Func: "<main>", Pos: ast.Position{Line: 5, Column: 1}},
+	}})
+	assert.Equal(t, "3:4 something\n\tat f (lib.ank:3:4)\n\tat <main> (5:1)\n", buf.String())
 }
@@ -0,0 +1,196 @@
+//go:build !appengine
+
+package astutil
+
+import (
+	"maps"
+	"slices"
+	"sort"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+)
+
+// HostIdents returns the identifiers the script expects from the host env, sorted: the ones it uses but never defines,
+// and the ones a top level statement reads before the script assigns them, ex: count = count + 1.
+// The functions defined by a statement are not run by it, the identifiers they read are only checked against the whole script,
+// and their parameters are only defined inside of them.
+func HostIdents(stmt ast.Stmt) []string {
+	out := hostIdents(stmt)
+	names := make([]string, 0, len(out))
+	for name := range out {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func hostIdents(stmt ast.Stmt) map[string]struct{} {
+	used, defined := identNames(stmt, nil)
+	out := make(map[string]struct{})
+	for name := range used {
+		if _, ok := defined[name]; !ok {
+			out[name] = struct{}{}
+		}
+	}
+	stmts, ok := stmt.(*ast.StmtsStmt)
+	if !ok {
+		return out
+	}
+	assigned := make(map[string]struct{})
+	for _, s := range stmts.Stmts {
+		skip := funcBodyNodes(s)
+		targets := assignTargets(s, skip)
+		read, local := identNames(s, skip)
+		for name := range read {
+			_, isAssigned := assigned[name]
+			_, isLocal := local[name]
+			// The targets of an assignment are only assigned once its values are evaluated
+			if !isAssigned && (!isLocal || slices.Contains(targets, name)) {
+				out[name] = struct{}{}
+			}
+		}
+		maps.Copy(assigned, local)
+	}
+	return out
+}
+
+// identNames returns the identifiers read and the ones defined by the statement, the nodes in skip are neither read nor define anything
+func identNames(stmt ast.Stmt, skip map[any]struct{}) (used, defined map[string]struct{}) {
+	used = make(map[string]struct{})
+	defined = make(map[string]struct{})
+	define := func(names ...string) {
+		for _, name := range names {
+			defined[name] = struct{}{}
+		}
+	}
+	defineExprs := func(exprs ...ast.Expr) {
+		for _, ident := range patternIdents(exprs...) {
+			define(ident.Lit)
+		}
+	}
+	_ = Walk(stmt, func(node any, _ int) error {
+		if _, ok := skip[node]; ok {
+			return nil
+		}
+		switch n := node.(type) {
+		case *ast.IdentExpr:
+			used[n.Lit] = struct{}{}
+		case *ast.CallExpr:
+			if n.Name != "" {
+				used[n.Name] = struct{}{}
+			}
+		case *ast.LetsStmt:
+			if lhss, ok := n.Lhss.(*ast.ExprsExpr); ok {
+				defineExprs(lhss.Exprs...)
+			}
+		case *ast.LetsExpr:
+			defineExprs(n.Lhss...)
+		case *ast.ChanExpr:
+			defineExprs(n.Lhs)
+		case *ast.VarStmt:
+			define(n.Names...)
+			defineExprs(n.Pattern)
+		case *ast.ForStmt:
+			define(n.Vars...)
+			defineExprs(n.Pattern)
+		case *ast.ComprehensionExpr:
+			define(n.Vars...)
+			defineExprs(n.Pattern)
+		case *ast.TryStmt:
+			define(n.Var)
+		case *ast.CatchStmt:
+			define(n.Var)
+		case *ast.ModuleStmt:
+			define(n.Name)
+		case *ast.EnumStmt:
+			define(n.Name)
+		case *ast.ImportStmt:
+			define(n.Name)
+		case *ast.FuncExpr:
+			if n.Receiver == nil {
+				define(n.Name)
+			} else if _, ok := skip[n.Receiver]; !ok {
+				define(n.Receiver.Name)
+			}
+			for _, param := range n.Params {
+				if _, ok := skip[param]; ok {
+					continue
+				}
+				if param.Pattern != nil {
+					defineExprs(param.Pattern)
+				} else {
+					define(param.Name)
+				}
+			}
+		}
+		return nil
+	})
+	return used, defined
+}
+
+// patternIdents returns the identifiers assigned by the left-hand side of an assignment or by a destructuring pattern
+func patternIdents(exprs ...ast.Expr) []*ast.IdentExpr {
+	var out []*ast.IdentExpr
+	for _, expr := range exprs {
+		switch e := expr.(type) {
+		case *ast.IdentExpr:
+			out = append(out, e)
+		case *ast.ArrayExpr: // destructuring pattern
+			out = append(out, patternIdents(e.Exprs.Exprs...)...)
+		case *ast.MapExpr:
+			out = append(out, patternIdents(e.Values.Exprs...)...)
+			for _, key := range e.Keys.Exprs {
+				if spread, ok := key.(*ast.SpreadExpr); ok {
+					out = append(out, patternIdents(spread)...)
+				}
+			}
+		case *ast.SpreadExpr:
+			out = append(out, patternIdents(e.Expr)...)
+		}
+	}
+	return out
+}
+
+// funcBodyNodes returns the receivers, the parameters and the nodes of the bodies of the functions defined by the statement
+func funcBodyNodes(stmt ast.Stmt) map[any]struct{} {
+	out := make(map[any]struct{})
+	add := func(node any, _ int) error {
+		out[node] = struct{}{}
+		return nil
+	}
+	_ = Walk(stmt, func(node any, _ int) error {
+		if fn, ok := node.(*ast.FuncExpr); ok {
+			if fn.Receiver != nil {
+				out[fn.Receiver] = struct{}{}
+			}
+			for _, param := range fn.Params {
+				out[param] = struct{}{}
+				_ = Walk(&ast.ExprStmt{Expr: param.Pattern}, add)
+			}
+			_ = Walk(fn.Stmt, add)
+		}
+		return nil
+	})
+	return out
+}
+
+// assignTargets returns the names assigned by the statement when it is an assignment or a var declaration,
+// their identifiers are added to skip, they are not read by the statement
+func assignTargets(stmt ast.Stmt, skip map[any]struct{}) []string {
+	var idents []*ast.IdentExpr
+	var names []string
+	switch s := stmt.(type) {
+	case *ast.LetsStmt:
+		if lhss, ok := s.Lhss.(*ast.ExprsExpr); ok {
+			idents = patternIdents(lhss.Exprs...)
+		}
+	case *ast.VarStmt:
+		idents = patternIdents(s.Pattern)
+		names = append(names, s.Names...)
+	}
+	for _, ident := range idents {
+		skip[ident] = struct{}{}
+		names = append(names, ident.Lit)
+	}
+	return names
+}
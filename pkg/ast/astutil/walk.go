@@ -49,6 +49,10 @@ func walkStmt(stmt ast.Stmt, f WalkFunc, deep int) error {
 	if stmt == nil || f == nil {
 		return nil
 	}
+	return walkCommented(stmt, f, deep, walkStmtNode)
+}
+
+func walkStmtNode(stmt ast.Stmt, f WalkFunc, deep int) error {
 	if err := callFunc(stmt, f, deep); err != nil {
 		return err
 	}
@@ -60,6 +64,9 @@ func walkStmt(stmt ast.Stmt, f WalkFunc, deep int) error {
 		}
 	case *ast.BreakStmt:
 	case *ast.ContinueStmt:
+	case *ast.TypeStmt:
+	case *ast.EnumStmt:
+	case *ast.ImportStmt:
 	case *ast.LetMapItemStmt:
 		if err := walkExpr(stmt.Lhss, f, deep); err != nil {
 			return err
@@ -72,6 +79,9 @@ func walkStmt(stmt ast.Stmt, f WalkFunc, deep int) error {
 	case *ast.ExprStmt:
 		return walkExpr(stmt.Expr, f, deep)
 	case *ast.VarStmt:
+		if err := walkExpr(stmt.Pattern, f, deep); err != nil {
+			return err
+		}
 		return walkExprs(stmt.Exprs, f, deep)
 	case *ast.LetsStmt:
 		if err := walkExpr(stmt.Lhss, f, deep); err != nil {
@@ -95,12 +105,24 @@ func walkStmt(stmt ast.Stmt, f WalkFunc, deep int) error {
 		if err := WalkHelper(stmt.Try, f, deep); err != nil {
 			return err
 		}
+		for _, c := range stmt.Catches {
+			if err := WalkHelper(c, f, deep); err != nil {
+				return err
+			}
+		}
 		if err := WalkHelper(stmt.Catch, f, deep); err != nil {
 			return err
 		}
 		if err := WalkHelper(stmt.Finally, f, deep); err != nil {
 			return err
 		}
+	case *ast.CatchStmt:
+		if err := walkExprs(stmt.Kinds, f, deep); err != nil {
+			return err
+		}
+		if err := WalkHelper(stmt.Stmt, f, deep); err != nil {
+			return err
+		}
 	case *ast.LoopStmt:
 		if err := walkExpr(stmt.Expr, f, deep); err != nil {
 			return err
@@ -109,6 +131,9 @@ func walkStmt(stmt ast.Stmt, f WalkFunc, deep int) error {
 			return err
 		}
 	case *ast.ForStmt:
+		if err := walkExpr(stmt.Pattern, f, deep); err != nil {
+			return err
+		}
 		if err := walkExpr(stmt.Value, f, deep); err != nil {
 			return err
 		}
@@ -132,10 +157,18 @@ func walkStmt(stmt ast.Stmt, f WalkFunc, deep int) error {
 		if err := walkExpr(stmt.Expr, f, deep); err != nil {
 			return err
 		}
+	case *ast.YieldStmt:
+		if err := walkExpr(stmt.Expr, f, deep); err != nil {
+			return err
+		}
 	case *ast.ModuleStmt:
 		if err := WalkHelper(stmt.Stmt, f, deep); err != nil {
 			return err
 		}
+	case *ast.ExportStmt:
+		if err := WalkHelper(stmt.Stmt, f, deep); err != nil {
+			return err
+		}
 	case *ast.SwitchStmt:
 		if err := walkExpr(stmt.Expr, f, deep); err != nil {
 			return err
@@ -151,6 +184,33 @@ func walkStmt(stmt ast.Stmt, f WalkFunc, deep int) error {
 		}
 	case *ast.GoroutineStmt:
 		return walkExpr(stmt.Expr, f, deep)
+	case *ast.DeferStmt:
+		return walkExpr(stmt.Expr, f, deep)
+	case *ast.WithStmt:
+		if err := walkExpr(stmt.Expr, f, deep); err != nil {
+			return err
+		}
+		return WalkHelper(stmt.Stmt, f, deep)
+	case *ast.DbgStmt:
+		return walkExpr(stmt.Expr, f, deep)
+	case *ast.LabelStmt:
+		return WalkHelper(stmt.Stmt, f, deep)
+	case *ast.SelectStmt:
+		return WalkHelper(stmt.Body, f, deep)
+	case *ast.SelectBodyStmt:
+		if err := walkStmts(stmt.Cases, f, deep); err != nil {
+			return err
+		}
+		if err := walkStmt(stmt.Default, f, deep); err != nil {
+			return err
+		}
+	case *ast.SelectCaseStmt:
+		if err := walkStmt(stmt.Expr, f, deep); err != nil {
+			return err
+		}
+		if err := walkStmt(stmt.Stmt, f, deep); err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("unknown statement %v", reflect.TypeOf(stmt))
 	}
@@ -162,6 +222,10 @@ func walkExpr(expr ast.Expr, f WalkFunc, deep int) error {
 	if expr == nil || f == nil {
 		return nil
 	}
+	return walkCommented(expr, f, deep, walkExprNode)
+}
+
+func walkExprNode(expr ast.Expr, f WalkFunc, deep int) error {
 	if err := callFunc(expr, f, deep); err != nil {
 		return err
 	}
@@ -173,11 +237,15 @@ func walkExpr(expr ast.Expr, f WalkFunc, deep int) error {
 			}
 		}
 	case *ast.LenExpr:
+		return walkExpr(expr.Expr, f, deep)
 	case *ast.NumberExpr:
+	case *ast.DurationExpr:
 	case *ast.IdentExpr:
 	case *ast.MemberExpr:
 		return walkExpr(expr.Expr, f, deep)
 	case *ast.StringExpr:
+	case *ast.InterpExpr:
+		return walkExprs(expr.Exprs, f, deep)
 	case *ast.ItemExpr:
 		if err := walkExpr(expr.Value, f, deep); err != nil {
 			return err
@@ -193,6 +261,8 @@ func walkExpr(expr ast.Expr, f WalkFunc, deep int) error {
 		return walkExpr(expr.End, f, deep)
 	case *ast.ArrayExpr:
 		return walkExpr(expr.Exprs, f, deep)
+	case *ast.StructExpr:
+		return walkExpr(expr.Values, f, deep)
 	case *ast.MapExpr:
 		if expr.Keys != nil {
 			for i := range expr.Keys.Exprs {
@@ -213,6 +283,11 @@ func walkExpr(expr ast.Expr, f WalkFunc, deep int) error {
 	case *ast.ParenExpr:
 		return walkExpr(expr.SubExpr, f, deep)
 	case *ast.FuncExpr:
+		for _, param := range expr.Params {
+			if err := walkExpr(param.Pattern, f, deep); err != nil {
+				return err
+			}
+		}
 		return WalkHelper(expr.Stmt, f, deep)
 	case *ast.AssocExpr:
 		if err := walkExpr(expr.Lhs, f, deep); err != nil {
@@ -265,12 +340,74 @@ func walkExpr(expr ast.Expr, f WalkFunc, deep int) error {
 		if err := walkExpr(expr.Lhs, f, deep); err != nil {
 			return err
 		}
+	case *ast.NilCoalescingOpExpr:
+		if err := walkExpr(expr.Lhs, f, deep); err != nil {
+			return err
+		}
+		return walkExpr(expr.Rhs, f, deep)
+	case *ast.MakeTypeExpr:
+		return walkExpr(expr.Type, f, deep)
+	case *ast.CloseExpr:
+		return walkExpr(expr.WhatExpr, f, deep)
+	case *ast.DeleteExpr:
+		if err := walkExpr(expr.WhatExpr, f, deep); err != nil {
+			return err
+		}
+		return walkExpr(expr.KeyExpr, f, deep)
+	case *ast.IncludeExpr:
+		if err := walkExpr(expr.ItemExpr, f, deep); err != nil {
+			return err
+		}
+		return walkExpr(expr.ListExpr, f, deep)
+	case *ast.SpreadExpr:
+		return walkExpr(expr.Expr, f, deep)
+	case *ast.ComprehensionExpr:
+		if err := walkExpr(expr.Pattern, f, deep); err != nil {
+			return err
+		}
+		if err := walkExpr(expr.Value, f, deep); err != nil {
+			return err
+		}
+		if err := walkExpr(expr.Cond, f, deep); err != nil {
+			return err
+		}
+		if err := walkExpr(expr.Key, f, deep); err != nil {
+			return err
+		}
+		return walkExpr(expr.Expr, f, deep)
+	case *ast.AsyncExpr:
+		return walkExpr(expr.Expr, f, deep)
+	case *ast.AwaitExpr:
+		for _, e := range expr.Exprs {
+			if err := walkExpr(e, f, deep); err != nil {
+				return err
+			}
+		}
+		return walkExpr(expr.Timeout, f, deep)
 	default:
 		return fmt.Errorf("unknown expression %v", reflect.TypeOf(expr))
 	}
 	return nil
 }
 
+// walkCommented passes the leading comments of the node to the WalkFunc, then walks the node and its children,
+// then passes its trailing comments. The comment groups are passed as *ast.CommentGroup, at the depth of the node.
+func walkCommented[T ast.Commented](node T, f WalkFunc, deep int, walkNode func(T, WalkFunc, int) error) error {
+	comments := node.Comments()
+	if comments != nil && comments.Leading != nil {
+		if err := callFunc(comments.Leading, f, deep); err != nil {
+			return err
+		}
+	}
+	if err := walkNode(node, f, deep); err != nil {
+		return err
+	}
+	if comments != nil && comments.Trailing != nil {
+		return callFunc(comments.Trailing, f, deep)
+	}
+	return nil
+}
+
 func callFunc(x any, f WalkFunc, deep int) error {
 	if x == nil || f == nil {
 		return nil
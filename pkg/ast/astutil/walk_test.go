@@ -167,6 +167,23 @@ func TestWalk(t *testing.T) {
 	}
 }
 
+func TestWalk_Comments(t *testing.T) {
+	stmts, err := parser.ParseSrc("# leading\na = 1 # trailing\nb = 2")
+	assert.NoError(t, err)
+	var out []string
+	err = Walk(stmts, func(e any, deep int) error {
+		switch e := e.(type) {
+		case *ast.CommentGroup:
+			out = append(out, fmt.Sprintf("%d %s", deep, e.Text()))
+		case *ast.LetsStmt:
+			out = append(out, fmt.Sprintf("%d let", deep))
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1 leading", "1 let", "1 trailing", "1 let"}, out)
+}
+
 func Example_astWalk() {
 	src := `
 var fmt = import("fmt")
@@ -240,8 +257,8 @@ func TestBadCode(t *testing.T) {
 }
 
 func TestErrors(t *testing.T) {
-	type errStmtStruct struct{ ast.Stmt }
-	type errExprStruct struct{ ast.Expr }
+	type errStmtStruct struct{ ast.StmtImpl }
+	type errExprStruct struct{ ast.ExprImpl }
 	errStmt := &errStmtStruct{}
 	errExpr := &errExprStruct{}
 	fn := func(any, int) error { return nil }
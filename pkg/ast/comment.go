@@ -0,0 +1,66 @@
+package ast
+
+import "strings"
+
+// Comment is a comment of the source, its text includes the comment markers (#, // or /* */)
+type Comment struct {
+	PosImpl
+	Text     string
+	Trailing bool // the comment follows some code on the same line
+}
+
+// CommentGroup is a sequence of comments with no code in between
+type CommentGroup struct {
+	List []*Comment
+}
+
+// Text returns the text of the comments without the comment markers, one line per comment
+func (g *CommentGroup) Text() string {
+	if g == nil {
+		return ""
+	}
+	lines := make([]string, 0, len(g.List))
+	for _, c := range g.List {
+		text := c.Text
+		switch {
+		case strings.HasPrefix(text, "#"):
+			text = text[1:]
+		case strings.HasPrefix(text, "//"):
+			text = text[2:]
+		case strings.HasPrefix(text, "/*"):
+			text = strings.TrimSuffix(text[2:], "*/")
+		}
+		lines = append(lines, strings.TrimSpace(text))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Comments are the comment groups attached to a statement or an expression
+type Comments struct {
+	Leading  *CommentGroup // comments on the lines before the node
+	Trailing *CommentGroup // comments after the node, on its line or at the end of the block it ends
+}
+
+// Commented interface provides two functions to get/set the comments of an expression or a statement.
+type Commented interface {
+	Comments() *Comments
+	SetComments(*Comments)
+}
+
+// CommentsImpl provides commonly implementations for Commented.
+type CommentsImpl struct {
+	comments *Comments
+}
+
+// Comments returns the comments attached to the expression or statement, nil if there are none.
+func (x *CommentsImpl) Comments() *Comments {
+	if x == nil {
+		return nil
+	}
+	return x.comments
+}
+
+// SetComments attaches comments to the expression or statement.
+func (x *CommentsImpl) SetComments(comments *Comments) {
+	x.comments = comments
+}
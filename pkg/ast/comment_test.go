@@ -0,0 +1,25 @@
+package ast
+
+import "testing"
+
+func TestCommentGroup_Text(t *testing.T) {
+	group := &CommentGroup{List: []*Comment{{Text: "# a"}, {Text: "//b "}, {Text: "/* c */"}}}
+	if got := group.Text(); got != "a\nb\nc" {
+		t.Errorf("Text() = %q, want %q", got, "a\nb\nc")
+	}
+	if got := (*CommentGroup)(nil).Text(); got != "" {
+		t.Errorf("Text() = %q, want empty", got)
+	}
+}
+
+func TestCommentsImpl_Comments(t *testing.T) {
+	if got := (*CommentsImpl)(nil).Comments(); got != nil {
+		t.Errorf("Comments() = %v, want nil", got)
+	}
+	var stmt StmtsStmt
+	comments := &Comments{Leading: &CommentGroup{}}
+	stmt.SetComments(comments)
+	if got := stmt.Comments(); got != comments {
+		t.Errorf("Comments() = %v, want %v", got, comments)
+	}
+}
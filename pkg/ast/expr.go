@@ -7,12 +7,14 @@ import (
 // Expr provides all of interfaces for expression.
 type Expr interface {
 	Pos
+	Commented
 	expr()
 }
 
 // ExprImpl provide commonly implementations for Expr.
 type ExprImpl struct {
-	PosImpl // ExprImpl provide Pos() function.
+	PosImpl      // ExprImpl provide Pos() function.
+	CommentsImpl // ExprImpl provide Comments() function.
 }
 
 // expr provide restraint interface.
@@ -24,18 +26,39 @@ type NumberExpr struct {
 	Lit string
 }
 
+// DurationExpr provide duration literal expression, a number followed by time units. ex: 2s, 500ms, 1h30m
+type DurationExpr struct {
+	ExprImpl
+	Lit string
+}
+
 // StringExpr provide String expression.
 type StringExpr struct {
 	ExprImpl
 	Lit string
 }
 
+// InterpExpr provide string interpolation expression, ex: "Hello ${name}".
+// The values of the expressions are converted to strings and inserted between the literal parts.
+type InterpExpr struct {
+	ExprImpl
+	Strs  []string // literal parts, one more than the expressions
+	Exprs []Expr
+}
+
 // ExprsExpr ...
 type ExprsExpr struct {
 	ExprImpl
 	Exprs []Expr
 }
 
+// SpreadExpr provide the spread of a list or a map in a literal or a call, ex: [...a, 1], {...m} or f(...a),
+// or the rest element of a destructuring pattern, ex: [a, ...rest] = list.
+type SpreadExpr struct {
+	ExprImpl
+	Expr Expr
+}
+
 // ArrayExpr provide Array expression.
 type ArrayExpr struct {
 	ExprImpl
@@ -43,14 +66,33 @@ type ArrayExpr struct {
 	TypeData *TypeStruct
 }
 
+// StructExpr provide struct literal expression, the fields are given by name or in order.
+type StructExpr struct {
+	ExprImpl
+	TypeData *TypeStruct
+	Names    []string // names of the fields, empty when they are given in order
+	Values   *ExprsExpr
+}
+
 // MapExpr provide Map expression.
 type MapExpr struct {
 	ExprImpl
-	Keys     *ExprsExpr
+	Keys     *ExprsExpr // a SpreadExpr key copies the entries of a map, its value is nil
 	Values   *ExprsExpr
 	TypeData *TypeStruct
 }
 
+// ComprehensionExpr provide list and map comprehension expressions. ex: [x * 2 for x in a if x > 3], {k: v for k, v in m}
+type ComprehensionExpr struct {
+	ExprImpl
+	Key     Expr // key of the entries of a map comprehension, nil for a list comprehension
+	Expr    Expr
+	Vars    []string
+	Pattern Expr // destructuring pattern each value is unpacked into, instead of the variables
+	Value   Expr
+	Cond    Expr // filter of the values, optional
+}
+
 // IdentExpr provide identity expression.
 type IdentExpr struct {
 	ExprImpl
@@ -130,15 +172,17 @@ type AnonCallExpr struct {
 // MemberExpr provide expression to refer member.
 type MemberExpr struct {
 	ExprImpl
-	Expr Expr
-	Name string
+	Expr     Expr
+	Name     string
+	Optional bool // a?.b is nil when a is nil or has no member b
 }
 
 // ItemExpr provide expression to refer Map/Array item.
 type ItemExpr struct {
 	ExprImpl
-	Value Expr
-	Index Expr
+	Value    Expr
+	Index    Expr
+	Optional bool // a?[i] is nil when a is nil or has no item i
 }
 
 // SliceExpr provide expression to refer slice of Array.
@@ -158,16 +202,19 @@ type FuncReturnValuesExpr struct {
 type ParamExpr struct {
 	Name     string
 	TypeData *TypeStruct
+	Pattern  Expr // destructuring pattern the argument is unpacked into, instead of the name. ex: func f([a, b]) {}
 }
 
 // FuncExpr provide function expression.
 type FuncExpr struct {
 	ExprImpl
-	Name    string
-	Stmt    Stmt
-	Params  []*ParamExpr
-	Returns []*FuncReturnValuesExpr
-	VarArg  bool
+	Name      string
+	Stmt      Stmt
+	Params    []*ParamExpr
+	Returns   []*FuncReturnValuesExpr
+	VarArg    bool
+	Receiver  *ParamExpr // receiver of a method, nil for a function
+	Generator bool       // the function yields values, calling it returns a generator
 }
 
 // LetsExpr provide multiple expression of let.
@@ -244,3 +291,29 @@ type IncludeExpr struct {
 	ItemExpr Expr
 	ListExpr Expr
 }
+
+// AsyncExpr provide async expression, the call is run by a new goroutine. ex: t = async f(x)
+type AsyncExpr struct {
+	ExprImpl
+	Expr Expr // CallExpr or AnonCallExpr
+}
+
+// AwaitKind is the kinds of await expressions
+type AwaitKind int
+
+const (
+	// AwaitTask waits for a task, ex: await t
+	AwaitTask AwaitKind = iota
+	// AwaitAll waits for all the tasks, ex: await all(t1, t2)
+	AwaitAll
+	// AwaitRace waits for the first task done, ex: await race(t1, t2)
+	AwaitRace
+)
+
+// AwaitExpr provide await expression, waiting for the result of async tasks. ex: await t timeout 2s
+type AwaitExpr struct {
+	ExprImpl
+	Kind    AwaitKind
+	Exprs   []Expr // tasks awaited, a SpreadExpr gives a list of them
+	Timeout Expr   // optional
+}
@@ -0,0 +1,252 @@
+package obfuscate
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"slices"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+	"github.com/alaingilbert/anko/pkg/ast/astutil"
+)
+
+// Config of the obfuscation pass
+type Config struct {
+	Preserve       []string   // names defined by the host, the script can assign them so they are never renamed
+	StripPositions bool       // either or not to remove the positions of every node
+	Modules        []ast.Stmt // scripts imported as modules, the symbols they define are kept like in a module
+}
+
+// Obfuscate rewrites the AST in place and returns it.
+// Every identifier defined by the script (variables, functions, parameters, loop and catch variables) is renamed.
+// Identifiers the script never defines are expected from the host and are kept, so are the preserved names,
+// the modules and the symbols defined in them, since they are accessed by name as members of the module,
+// the symbols the script exports, in case it is imported as a module,
+// and the methods, accessed by name as members of a struct.
+func Obfuscate(stmt ast.Stmt, cfg *Config) ast.Stmt {
+	ObfuscateAll([]ast.Stmt{stmt}, cfg)
+	return stmt
+}
+
+// ObfuscateAll obfuscates several scripts sharing the same env, such as a script and the files it loads or imports.
+// A symbol defined in one of them gets the same name in all of them.
+func ObfuscateAll(stmts []ast.Stmt, cfg *Config) {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	keep := make(map[string]struct{})
+	for _, name := range cfg.Preserve {
+		keep[name] = struct{}{}
+	}
+	for _, module := range cfg.Modules {
+		for _, name := range definedNames(module) {
+			keep[name] = struct{}{}
+		}
+	}
+	names := make(map[string]string)
+	defined := make([][]string, len(stmts))
+	for i, stmt := range stmts {
+		_ = astutil.Walk(stmt, func(node any, _ int) error {
+			if module, ok := node.(*ast.ModuleStmt); ok {
+				keep[module.Name] = struct{}{}
+				for _, name := range definedNames(module.Stmt) {
+					keep[name] = struct{}{}
+				}
+			}
+			return nil
+		})
+		for _, name := range exportedNames(stmt) {
+			keep[name] = struct{}{}
+		}
+		defined[i] = definedNames(stmt)
+		for _, name := range defined[i] {
+			names[name] = ""
+		}
+	}
+	// The identifiers a script expects from the host keep their name, even if the script defines them in another scope,
+	// unless they are defined by another of the scripts
+	for i, stmt := range stmts {
+		for _, name := range astutil.HostIdents(stmt) {
+			if !definedByOthers(defined, i, name) {
+				keep[name] = struct{}{}
+			}
+		}
+	}
+	for name := range names {
+		if _, ok := keep[name]; ok || name == "" || name == "_" {
+			delete(names, name)
+			continue
+		}
+		names[name] = "id_" + generateToken()
+	}
+	for _, stmt := range stmts {
+		rename(stmt, names)
+		if cfg.StripPositions {
+			StripPositions(stmt)
+		}
+	}
+}
+
+// definedByOthers returns either or not the name is defined by another script than the i-th one
+func definedByOthers(defined [][]string, i int, name string) bool {
+	for j, names := range defined {
+		if j != i && slices.Contains(names, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// exportedNames returns the names of the symbols visible from outside of the script when it is imported as a module,
+// the ones declared by an export statement and the capitalized ones defined by its top level statements
+func exportedNames(stmt ast.Stmt) []string {
+	stmts := []ast.Stmt{stmt}
+	if s, ok := stmt.(*ast.StmtsStmt); ok {
+		stmts = s.Stmts
+	}
+	var out []string
+	for _, s := range stmts {
+		if export, ok := s.(*ast.ExportStmt); ok {
+			out = append(out, export.Names()...)
+		}
+		for _, name := range definedNames(s) {
+			if r, _ := utf8.DecodeRuneInString(name); unicode.IsUpper(r) {
+				out = append(out, name)
+			}
+		}
+	}
+	return out
+}
+
+// definedNames returns the names the script defines, in the order they are found
+func definedNames(stmt ast.Stmt) []string {
+	var out []string
+	var defineExprs func(exprs ...ast.Expr)
+	defineExprs = func(exprs ...ast.Expr) {
+		for _, expr := range exprs {
+			switch e := expr.(type) {
+			case *ast.IdentExpr:
+				out = append(out, e.Lit)
+			case *ast.ArrayExpr: // destructuring pattern
+				defineExprs(e.Exprs.Exprs...)
+			case *ast.MapExpr:
+				defineExprs(e.Values.Exprs...)
+				for _, key := range e.Keys.Exprs {
+					if spread, ok := key.(*ast.SpreadExpr); ok {
+						defineExprs(spread)
+					}
+				}
+			case *ast.SpreadExpr:
+				defineExprs(e.Expr)
+			}
+		}
+	}
+	_ = astutil.Walk(stmt, func(node any, _ int) error {
+		switch n := node.(type) {
+		case *ast.LetsStmt:
+			if lhss, ok := n.Lhss.(*ast.ExprsExpr); ok {
+				defineExprs(lhss.Exprs...)
+			}
+		case *ast.LetMapItemStmt:
+			if lhss, ok := n.Lhss.(*ast.ExprsExpr); ok {
+				defineExprs(lhss.Exprs...)
+			}
+		case *ast.LetsExpr:
+			defineExprs(n.Lhss...)
+		case *ast.ChanExpr:
+			defineExprs(n.Lhs)
+		case *ast.VarStmt:
+			out = append(out, n.Names...)
+			defineExprs(n.Pattern)
+		case *ast.ForStmt:
+			out = append(out, n.Vars...)
+			defineExprs(n.Pattern)
+		case *ast.ComprehensionExpr:
+			out = append(out, n.Vars...)
+			defineExprs(n.Pattern)
+		case *ast.TryStmt:
+			out = append(out, n.Var)
+		case *ast.CatchStmt:
+			out = append(out, n.Var)
+		case *ast.ImportStmt:
+			out = append(out, n.Name)
+		case *ast.FuncExpr:
+			if n.Receiver != nil {
+				out = append(out, n.Receiver.Name)
+			} else {
+				out = append(out, n.Name)
+			}
+			for _, param := range n.Params {
+				if param.Pattern != nil {
+					defineExprs(param.Pattern)
+				} else {
+					out = append(out, param.Name)
+				}
+			}
+		}
+		return nil
+	})
+	return out
+}
+
+func rename(stmt ast.Stmt, names map[string]string) {
+	renameStr := func(name *string) {
+		if newName, ok := names[*name]; ok {
+			*name = newName
+		}
+	}
+	renameStrs := func(names []string) {
+		for i := range names {
+			renameStr(&names[i])
+		}
+	}
+	_ = astutil.Walk(stmt, func(node any, _ int) error {
+		switch n := node.(type) {
+		case *ast.IdentExpr:
+			renameStr(&n.Lit)
+		case *ast.CallExpr:
+			renameStr(&n.Name)
+		case *ast.VarStmt:
+			renameStrs(n.Names)
+		case *ast.ForStmt:
+			renameStrs(n.Vars)
+		case *ast.ComprehensionExpr:
+			renameStrs(n.Vars)
+		case *ast.TryStmt:
+			renameStr(&n.Var)
+		case *ast.CatchStmt:
+			renameStr(&n.Var)
+		case *ast.ImportStmt:
+			renameStr(&n.Name)
+		case *ast.FuncExpr:
+			if n.Receiver != nil {
+				renameStr(&n.Receiver.Name)
+			} else {
+				renameStr(&n.Name)
+			}
+			for _, param := range n.Params {
+				renameStr(&param.Name)
+			}
+		}
+		return nil
+	})
+}
+
+// StripPositions sets the position of every node to 0:0 and returns the AST.
+// Errors are then reported without a position.
+func StripPositions(stmt ast.Stmt) ast.Stmt {
+	_ = astutil.Walk(stmt, func(node any, _ int) error {
+		if n, ok := node.(ast.Pos); ok {
+			n.SetPosition(ast.Position{})
+		}
+		return nil
+	})
+	return stmt
+}
+
+func generateToken() string {
+	b := make([]byte, 6)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,143 @@
+package obfuscate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+	"github.com/alaingilbert/anko/pkg/ast/astutil"
+	"github.com/alaingilbert/anko/pkg/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+// names returns the identifiers, call names, function names and parameters found in the AST
+func names(stmt ast.Stmt) map[string]bool {
+	out := make(map[string]bool)
+	_ = astutil.Walk(stmt, func(node any, _ int) error {
+		switch n := node.(type) {
+		case *ast.IdentExpr:
+			out[n.Lit] = true
+		case *ast.CallExpr:
+			out[n.Name] = true
+		case *ast.FuncExpr:
+			out[n.Name] = true
+			for _, param := range n.Params {
+				out[param.Name] = true
+			}
+		case *ast.ForStmt:
+			for _, v := range n.Vars {
+				out[v] = true
+			}
+		case *ast.TryStmt:
+			out[n.Var] = true
+		case *ast.VarStmt:
+			for _, v := range n.Names {
+				out[v] = true
+			}
+		}
+		return nil
+	})
+	return out
+}
+
+func TestObfuscate(t *testing.T) {
+	src := `
+var counter = 0
+func inc(step) { counter += step; return counter }
+for i in range(3) { inc(i) }
+try { throw "x" } catch err { println(err) }
+module m { Exported = 1 }
+host = inc(m.Exported)`
+	stmt, err := parser.ParseSrc(src)
+	assert.NoError(t, err)
+	found := names(Obfuscate(stmt, &Config{Preserve: []string{"host"}}))
+	for _, name := range []string{"counter", "inc", "step", "i", "err"} {
+		assert.False(t, found[name], name)
+	}
+	for _, name := range []string{"range", "println", "m", "Exported", "host"} {
+		assert.True(t, found[name], name)
+	}
+	for name := range found {
+		if name != "" && !strings.HasPrefix(name, "id_") {
+			assert.Contains(t, []string{"range", "println", "m", "Exported", "host"}, name)
+		}
+	}
+}
+
+func TestObfuscateAll(t *testing.T) {
+	main, err := parser.ParseSrc(`load("lib.ank"); helper(1)`)
+	assert.NoError(t, err)
+	lib, err := parser.ParseSrc(`func helper(x) { return x }`)
+	assert.NoError(t, err)
+	ObfuscateAll([]ast.Stmt{main, lib}, nil)
+	call := main.(*ast.StmtsStmt).Stmts[1].(*ast.ExprStmt).Expr.(*ast.CallExpr)
+	fn := lib.(*ast.StmtsStmt).Stmts[0].(*ast.ExprStmt).Expr.(*ast.FuncExpr)
+	assert.True(t, strings.HasPrefix(fn.Name, "id_"))
+	assert.Equal(t, fn.Name, call.Name)
+}
+
+func TestObfuscateAll_Modules(t *testing.T) {
+	main, err := parser.ParseSrc(`import "./lib.ank" as lib; y = lib.helper(1)`)
+	assert.NoError(t, err)
+	lib, err := parser.ParseSrc(`func helper(x) { return x }`)
+	assert.NoError(t, err)
+	ObfuscateAll([]ast.Stmt{main, lib}, &Config{Modules: []ast.Stmt{lib}})
+	stmts := main.(*ast.StmtsStmt).Stmts
+	assert.True(t, strings.HasPrefix(stmts[0].(*ast.ImportStmt).Name, "id_"))
+	fn := lib.(*ast.StmtsStmt).Stmts[0].(*ast.ExprStmt).Expr.(*ast.FuncExpr)
+	assert.Equal(t, "helper", fn.Name)
+	assert.Equal(t, "x", fn.Params[0].Name)
+	found := names(main)
+	assert.False(t, found["lib"])
+	assert.False(t, found["y"])
+}
+
+func TestObfuscate_Exports(t *testing.T) {
+	stmt, err := parser.ParseSrc("export func hello() { return secret }\nexport var count = 0\nHelper = func(x) { return x }\nsecret = 1")
+	assert.NoError(t, err)
+	found := names(Obfuscate(stmt, nil))
+	for _, name := range []string{"hello", "count", "Helper"} {
+		assert.True(t, found[name], name)
+	}
+	assert.False(t, found["secret"])
+	assert.False(t, found["x"])
+}
+
+func TestObfuscate_Methods(t *testing.T) {
+	stmt, err := parser.ParseSrc("type Point struct { X int64 }\nfunc (p Point) Get(n) { return p.X + n }\nPoint{X: 1}.Get(2)")
+	assert.NoError(t, err)
+	Obfuscate(stmt, nil)
+	fn := stmt.(*ast.StmtsStmt).Stmts[1].(*ast.ExprStmt).Expr.(*ast.FuncExpr)
+	assert.Equal(t, "Get", fn.Name)
+	assert.True(t, strings.HasPrefix(fn.Receiver.Name, "id_"))
+	assert.True(t, strings.HasPrefix(fn.Params[0].Name, "id_"))
+	lit := stmt.(*ast.StmtsStmt).Stmts[2].(*ast.ExprStmt).Expr.(*ast.AnonCallExpr).Expr.(*ast.MemberExpr).Expr.(*ast.StructExpr)
+	assert.Equal(t, []string{"X"}, lit.Names)
+}
+
+func TestStripPositions(t *testing.T) {
+	stmt, err := parser.ParseSrc("a = 1\nb = a + 2")
+	assert.NoError(t, err)
+	Obfuscate(stmt, &Config{StripPositions: true})
+	_ = astutil.Walk(stmt, func(node any, _ int) error {
+		if n, ok := node.(ast.Pos); ok {
+			assert.Equal(t, ast.Position{}, n.Position())
+		}
+		return nil
+	})
+}
+
+func TestObfuscate_Patterns(t *testing.T) {
+	src := "[a, ...rest] = list\n{name} = person\nvar [b] = list\nfunc f([c], {d}) { return c }\nfor [k, v] in pairs { println(k, v) }"
+	stmt, err := parser.ParseSrc(src)
+	assert.NoError(t, err)
+	found := names(Obfuscate(stmt, nil))
+	for _, name := range []string{"a", "rest", "name", "b", "c", "d", "k", "v"} {
+		assert.False(t, found[name], name)
+	}
+	for _, name := range []string{"list", "person", "pairs", "println"} {
+		assert.True(t, found[name], name)
+	}
+	key := stmt.(*ast.StmtsStmt).Stmts[1].(*ast.LetsStmt).Lhss.(*ast.ExprsExpr).Exprs[0].(*ast.MapExpr).Keys.Exprs[0]
+	assert.Equal(t, "name", key.(*ast.StringExpr).Lit)
+}
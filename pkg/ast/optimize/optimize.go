@@ -0,0 +1,560 @@
+package optimize
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+)
+
+// maxFoldedStringLen is the maximum length of a string produced by folding a string repetition
+const maxFoldedStringLen = 1024
+
+// Optimize rewrites the AST in place and returns it.
+// It folds constant expressions, inlines parenthesized expressions and removes unreachable code.
+// Folded nodes keep the position of the expression they replace, so errors are still reported at the right place.
+func Optimize(stmt ast.Stmt) ast.Stmt {
+	return optimizeStmt(stmt)
+}
+
+func optimizeStmts(stmts []ast.Stmt) []ast.Stmt {
+	for i := range stmts {
+		stmts[i] = optimizeStmt(stmts[i])
+	}
+	return stmts
+}
+
+func optimizeExprs(exprs []ast.Expr) []ast.Expr {
+	for i := range exprs {
+		exprs[i] = optimizeExpr(exprs[i])
+	}
+	return exprs
+}
+
+func optimizeExprsExpr(e *ast.ExprsExpr) *ast.ExprsExpr {
+	if e != nil {
+		e.Exprs = optimizeExprs(e.Exprs)
+	}
+	return e
+}
+
+func optimizeStmt(stmt ast.Stmt) ast.Stmt {
+	switch s := stmt.(type) {
+	case *ast.StmtsStmt:
+		s.Stmts = optimizeStmtsStmt(optimizeStmts(s.Stmts))
+	case *ast.ExprStmt:
+		s.Expr = optimizeExpr(s.Expr)
+	case *ast.DbgStmt:
+		s.Expr = optimizeExpr(s.Expr)
+	case *ast.LabelStmt:
+		s.Stmt = optimizeStmt(s.Stmt)
+	case *ast.IfStmt:
+		optimizeIfStmt(s)
+	case *ast.TryStmt:
+		s.Try = optimizeStmt(s.Try)
+		for _, c := range s.Catches {
+			c.Kinds = optimizeExprs(c.Kinds)
+			c.Stmt = optimizeStmt(c.Stmt)
+		}
+		s.Catch = optimizeStmt(s.Catch)
+		s.Finally = optimizeStmt(s.Finally)
+	case *ast.ForStmt:
+		s.Pattern = optimizeExpr(s.Pattern)
+		s.Value = optimizeExpr(s.Value)
+		s.Stmt = optimizeStmt(s.Stmt)
+	case *ast.CForStmt:
+		s.Stmt1 = optimizeStmt(s.Stmt1)
+		s.Expr2 = optimizeExpr(s.Expr2)
+		s.Expr3 = optimizeExpr(s.Expr3)
+		s.Stmt = optimizeStmt(s.Stmt)
+	case *ast.LoopStmt:
+		s.Expr = optimizeExpr(s.Expr)
+		s.Stmt = optimizeStmt(s.Stmt)
+	case *ast.ReturnStmt:
+		s.Exprs = optimizeExpr(s.Exprs)
+	case *ast.ThrowStmt:
+		s.Expr = optimizeExpr(s.Expr)
+	case *ast.YieldStmt:
+		s.Expr = optimizeExpr(s.Expr)
+	case *ast.ModuleStmt:
+		s.Stmt = optimizeStmt(s.Stmt)
+	case *ast.ExportStmt:
+		s.Stmt = optimizeStmt(s.Stmt)
+	case *ast.SelectStmt:
+		s.Body = optimizeStmt(s.Body)
+	case *ast.SelectBodyStmt:
+		s.Cases = optimizeStmts(s.Cases)
+		s.Default = optimizeStmt(s.Default)
+	case *ast.SelectCaseStmt:
+		s.Expr = optimizeStmt(s.Expr)
+		s.Stmt = optimizeStmt(s.Stmt)
+	case *ast.SwitchStmt:
+		s.Expr = optimizeExpr(s.Expr)
+		s.Cases = optimizeStmts(s.Cases)
+		s.Default = optimizeStmt(s.Default)
+	case *ast.SwitchCaseStmt:
+		s.Exprs = optimizeExprsExpr(s.Exprs)
+		s.Stmt = optimizeStmt(s.Stmt)
+	case *ast.VarStmt:
+		s.Pattern = optimizeExpr(s.Pattern)
+		s.Exprs = optimizeExprs(s.Exprs)
+	case *ast.LetsStmt:
+		s.Lhss = optimizeExpr(s.Lhss)
+		s.Rhss = optimizeExpr(s.Rhss)
+	case *ast.LetMapItemStmt:
+		s.Lhss = optimizeExpr(s.Lhss)
+		s.Rhs = optimizeExpr(s.Rhs)
+	case *ast.GoroutineStmt:
+		s.Expr = optimizeExpr(s.Expr)
+	case *ast.DeferStmt:
+		s.Expr = optimizeExpr(s.Expr)
+	case *ast.WithStmt:
+		s.Expr = optimizeExpr(s.Expr)
+		s.Stmt = optimizeStmt(s.Stmt)
+	}
+	return stmt
+}
+
+// optimizeStmtsStmt removes the statements that can never be reached
+func optimizeStmtsStmt(stmts []ast.Stmt) []ast.Stmt {
+	out := stmts[:0]
+	for i, stmt := range stmts {
+		// An "if" that can never be taken is dropped, unless it is the last statement,
+		// in which case it still provides the value of the block.
+		if s, ok := stmt.(*ast.IfStmt); ok && s.Else == nil && i < len(stmts)-1 {
+			if b, ok := constBool(s.If); ok && !b {
+				continue
+			}
+		}
+		out = append(out, stmt)
+		switch stmt.(type) {
+		case *ast.ReturnStmt, *ast.ThrowStmt, *ast.BreakStmt, *ast.ContinueStmt:
+			return out
+		}
+	}
+	return out
+}
+
+// optimizeIfStmt drops the branch that cannot be taken when the condition is constant.
+// The remaining branch is kept inside the "if" so that it still runs in its own scope.
+func optimizeIfStmt(s *ast.IfStmt) {
+	s.If = optimizeExpr(s.If)
+	s.Then = optimizeStmt(s.Then)
+	s.Else = optimizeStmt(s.Else)
+	b, ok := constBool(s.If)
+	if !ok {
+		return
+	}
+	if !b && s.Else != nil {
+		cond := &ast.ConstExpr{Value: "true"}
+		cond.SetPosition(s.If.Position())
+		s.If = cond
+		s.Then = s.Else
+	}
+	s.Else = nil
+}
+
+func optimizeExpr(expr ast.Expr) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return optimizeExpr(e.SubExpr)
+	case *ast.UnaryExpr:
+		return optimizeUnaryExpr(e)
+	case *ast.BinOpExpr:
+		return optimizeBinOpExpr(e)
+	case *ast.TernaryOpExpr:
+		return optimizeTernaryOpExpr(e)
+	case *ast.ExprsExpr:
+		e.Exprs = optimizeExprs(e.Exprs)
+	case *ast.ArrayExpr:
+		e.Exprs = optimizeExprsExpr(e.Exprs)
+	case *ast.MapExpr:
+		e.Keys = optimizeExprsExpr(e.Keys)
+		e.Values = optimizeExprsExpr(e.Values)
+	case *ast.StructExpr:
+		e.Values = optimizeExprsExpr(e.Values)
+	case *ast.InterpExpr:
+		e.Exprs = optimizeExprs(e.Exprs)
+	case *ast.AddrExpr:
+		e.Expr = optimizeExpr(e.Expr)
+	case *ast.DerefExpr:
+		e.Expr = optimizeExpr(e.Expr)
+	case *ast.NilCoalescingOpExpr:
+		e.Lhs = optimizeExpr(e.Lhs)
+		e.Rhs = optimizeExpr(e.Rhs)
+	case *ast.CallExpr:
+		e.SubExprs = optimizeExprsExpr(e.SubExprs)
+	case *ast.AnonCallExpr:
+		e.Expr = optimizeExpr(e.Expr)
+		e.SubExprs = optimizeExprsExpr(e.SubExprs)
+	case *ast.MemberExpr:
+		e.Expr = optimizeExpr(e.Expr)
+	case *ast.ItemExpr:
+		e.Value = optimizeExpr(e.Value)
+		e.Index = optimizeExpr(e.Index)
+	case *ast.SliceExpr:
+		e.Value = optimizeExpr(e.Value)
+		e.Begin = optimizeExpr(e.Begin)
+		e.End = optimizeExpr(e.End)
+	case *ast.FuncExpr:
+		for _, param := range e.Params {
+			param.Pattern = optimizeExpr(param.Pattern)
+		}
+		e.Stmt = optimizeStmt(e.Stmt)
+	case *ast.LetsExpr:
+		e.Lhss = optimizeExprs(e.Lhss)
+		e.Rhss = optimizeExprs(e.Rhss)
+	case *ast.AssocExpr:
+		e.Lhs = optimizeExpr(e.Lhs)
+		e.Rhs = optimizeExpr(e.Rhs)
+	case *ast.ChanExpr:
+		e.Lhs = optimizeExpr(e.Lhs)
+		e.Rhs = optimizeExpr(e.Rhs)
+	case *ast.MakeExpr:
+		e.LenExpr = optimizeExpr(e.LenExpr)
+		e.CapExpr = optimizeExpr(e.CapExpr)
+	case *ast.MakeTypeExpr:
+		e.Type = optimizeExpr(e.Type)
+	case *ast.LenExpr:
+		e.Expr = optimizeExpr(e.Expr)
+	case *ast.CloseExpr:
+		e.WhatExpr = optimizeExpr(e.WhatExpr)
+	case *ast.DeleteExpr:
+		e.WhatExpr = optimizeExpr(e.WhatExpr)
+		e.KeyExpr = optimizeExpr(e.KeyExpr)
+	case *ast.IncludeExpr:
+		e.ItemExpr = optimizeExpr(e.ItemExpr)
+		e.ListExpr = optimizeExpr(e.ListExpr)
+	case *ast.SpreadExpr:
+		e.Expr = optimizeExpr(e.Expr)
+	case *ast.ComprehensionExpr:
+		e.Key = optimizeExpr(e.Key)
+		e.Expr = optimizeExpr(e.Expr)
+		e.Pattern = optimizeExpr(e.Pattern)
+		e.Value = optimizeExpr(e.Value)
+		e.Cond = optimizeExpr(e.Cond)
+	case *ast.AsyncExpr:
+		e.Expr = optimizeExpr(e.Expr)
+	case *ast.AwaitExpr:
+		e.Exprs = optimizeExprs(e.Exprs)
+		e.Timeout = optimizeExpr(e.Timeout)
+	}
+	return expr
+}
+
+func optimizeUnaryExpr(e *ast.UnaryExpr) ast.Expr {
+	e.Expr = optimizeExpr(e.Expr)
+	v, ok := constValue(e.Expr)
+	if !ok {
+		return e
+	}
+	var out any
+	switch e.Operator {
+	case "-":
+		switch vv := v.(type) {
+		case int64:
+			out = -vv
+		case float64:
+			out = -vv
+		}
+	case "^":
+		switch vv := v.(type) {
+		case int64:
+			out = ^vv
+		case float64:
+			out = ^int64(vv)
+		}
+	case "!":
+		if b, ok := toBool(v); ok {
+			out = !b
+		}
+	}
+	return replaceExpr(e, out)
+}
+
+func optimizeBinOpExpr(e *ast.BinOpExpr) ast.Expr {
+	e.Lhs = optimizeExpr(e.Lhs)
+	e.Rhs = optimizeExpr(e.Rhs)
+	lhs, ok := constValue(e.Lhs)
+	if !ok {
+		return e
+	}
+	switch e.Operator {
+	case "&&", "||":
+		// The value of the left-hand side is returned when the right-hand side is not evaluated,
+		// nothing is folded when the truth value of the left-hand side is not known
+		b, ok := toBool(lhs)
+		if !ok {
+			return e
+		}
+		if b == (e.Operator == "||") {
+			return e.Lhs
+		}
+	}
+	rhs, ok := constValue(e.Rhs)
+	if !ok {
+		return e
+	}
+	switch e.Operator {
+	case "&&", "||":
+		return e.Rhs
+	}
+	return replaceExpr(e, foldBinOp(e.Operator, lhs, rhs))
+}
+
+func optimizeTernaryOpExpr(e *ast.TernaryOpExpr) ast.Expr {
+	e.Expr = optimizeExpr(e.Expr)
+	e.Lhs = optimizeExpr(e.Lhs)
+	e.Rhs = optimizeExpr(e.Rhs)
+	b, ok := constBool(e.Expr)
+	if !ok {
+		return e
+	}
+	if b {
+		return e.Lhs
+	}
+	return e.Rhs
+}
+
+// replaceExpr returns a literal holding v at the position of e, or e itself if v cannot be represented as a literal
+func replaceExpr(e ast.Expr, v any) ast.Expr {
+	out, ok := literalExpr(v)
+	if !ok {
+		return e
+	}
+	out.SetPosition(e.Position())
+	return out
+}
+
+// foldBinOp computes the binary operation the same way the runner does.
+// It returns nil if the operation cannot be computed ahead of time.
+func foldBinOp(operator string, lhs, rhs any) any {
+	lhsStr, lhsIsStr := lhs.(string)
+	_, rhsIsStr := rhs.(string)
+	lhsIsNum, rhsIsNum := isNumber(lhs), isNumber(rhs)
+	switch operator {
+	case "+":
+		if lhsIsStr || rhsIsStr {
+			if (lhsIsStr || lhsIsNum) && (rhsIsStr || rhsIsNum) {
+				return fmt.Sprint(lhs) + fmt.Sprint(rhs)
+			}
+			return nil
+		}
+	case "*":
+		if lhsIsStr {
+			if count, ok := rhs.(int64); ok && count >= 0 && int64(len(lhsStr))*count <= maxFoldedStringLen {
+				return strings.Repeat(lhsStr, int(count))
+			}
+			return nil
+		}
+	case "==", "!=":
+		eq, ok := equal(lhs, rhs)
+		if !ok {
+			return nil
+		}
+		return eq == (operator == "==")
+	}
+	if !lhsIsNum || !rhsIsNum {
+		return nil
+	}
+	_, lhsIsFloat := lhs.(float64)
+	_, rhsIsFloat := rhs.(float64)
+	isFloat := lhsIsFloat || rhsIsFloat
+	lf, rf := toFloat64(lhs), toFloat64(rhs)
+	li, ri := toInt64(lhs), toInt64(rhs)
+	switch operator {
+	case "+":
+		if isFloat {
+			return lf + rf
+		}
+		return li + ri
+	case "-":
+		if isFloat {
+			return lf - rf
+		}
+		return li - ri
+	case "*":
+		if isFloat {
+			return lf * rf
+		}
+		return li * ri
+	case "/":
+		return lf / rf
+	case "%":
+		if ri == 0 {
+			return nil
+		}
+		return li % ri
+	case ">":
+		return lf > rf
+	case ">=":
+		return lf >= rf
+	case "<":
+		return lf < rf
+	case "<=":
+		return lf <= rf
+	case "|":
+		return li | ri
+	case "&":
+		return li & ri
+	case "**":
+		if lhsIsFloat {
+			return math.Pow(lf, rf)
+		}
+		p := math.Pow(lf, rf)
+		if math.IsNaN(p) || p < math.MinInt64 || p >= math.MaxInt64 {
+			return nil
+		}
+		return int64(p)
+	case ">>":
+		return li >> uint64(ri)
+	case "<<":
+		return li << uint64(ri)
+	}
+	return nil
+}
+
+// equal compares two constants the same way the runner does, ok is false if they cannot be compared ahead of time
+func equal(lhs, rhs any) (eq, ok bool) {
+	if isNumber(lhs) && isNumber(rhs) {
+		return fmt.Sprintf("%v", lhs) == fmt.Sprintf("%v", rhs), true
+	}
+	switch l := lhs.(type) {
+	case string:
+		if r, ok := rhs.(string); ok {
+			return l == r, true
+		}
+	case bool:
+		if r, ok := rhs.(bool); ok {
+			return l == r, true
+		}
+	}
+	return false, false
+}
+
+// constValue returns the value of a literal expression
+func constValue(expr ast.Expr) (any, bool) {
+	switch e := expr.(type) {
+	case *ast.NumberExpr:
+		return parseNumber(e.Lit)
+	case *ast.StringExpr:
+		return e.Lit, true
+	case *ast.ConstExpr:
+		switch e.Value {
+		case "true":
+			return true, true
+		case "false":
+			return false, true
+		}
+	}
+	return nil, false
+}
+
+// constBool returns the truth value of a literal expression
+func constBool(expr ast.Expr) (bool, bool) {
+	v, ok := constValue(expr)
+	if !ok {
+		return false, false
+	}
+	return toBool(v)
+}
+
+// literalExpr builds the literal expression that evaluates to v
+func literalExpr(v any) (ast.Expr, bool) {
+	switch vv := v.(type) {
+	case int64:
+		return &ast.NumberExpr{Lit: strconv.FormatInt(vv, 10)}, true
+	case float64:
+		if math.IsInf(vv, 0) || math.IsNaN(vv) {
+			return nil, false
+		}
+		lit := strconv.FormatFloat(vv, 'g', -1, 64)
+		if !strings.ContainsAny(lit, ".e") {
+			lit += ".0"
+		}
+		return &ast.NumberExpr{Lit: lit}, true
+	case string:
+		return &ast.StringExpr{Lit: vv}, true
+	case bool:
+		return &ast.ConstExpr{Value: strconv.FormatBool(vv)}, true
+	}
+	return nil, false
+}
+
+// parseNumber parses a number literal the same way the runner does
+func parseNumber(lit string) (any, bool) {
+	if strings.Contains(lit, ".") || strings.Contains(lit, "e") {
+		f, err := strconv.ParseFloat(lit, 64)
+		if err != nil {
+			return nil, false
+		}
+		return f, true
+	}
+	var i int64
+	var err error
+	if strings.HasPrefix(lit, "0x") {
+		i, err = strconv.ParseInt(lit[2:], 16, 64)
+	} else if strings.HasPrefix(lit, "0b") {
+		i, err = strconv.ParseInt(lit[2:], 2, 64)
+	} else {
+		i, err = strconv.ParseInt(lit, 10, 64)
+	}
+	if err != nil {
+		return nil, false
+	}
+	return i, true
+}
+
+func isNumber(v any) bool {
+	switch v.(type) {
+	case int64, float64:
+		return true
+	}
+	return false
+}
+
+// toBool returns the truth value of a constant, like the runner does
+func toBool(v any) (bool, bool) {
+	switch vv := v.(type) {
+	case bool:
+		return vv, true
+	case int64:
+		return vv != 0, true
+	case float64:
+		return vv != 0, true
+	case string:
+		if vv == "" {
+			return false, true
+		}
+		if b, err := strconv.ParseBool(vv); err == nil && !b {
+			return false, true
+		}
+		if f, err := strconv.ParseFloat(vv, 64); err == nil && f == 0 {
+			return false, true
+		}
+		return true, true
+	}
+	return false, false
+}
+
+func toFloat64(v any) float64 {
+	switch vv := v.(type) {
+	case int64:
+		return float64(vv)
+	case float64:
+		return vv
+	}
+	return 0
+}
+
+func toInt64(v any) int64 {
+	switch vv := v.(type) {
+	case int64:
+		return vv
+	case float64:
+		return int64(vv)
+	}
+	return 0
+}
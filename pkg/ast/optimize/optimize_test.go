@@ -0,0 +1,154 @@
+package optimize
+
+import (
+	"testing"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+	"github.com/alaingilbert/anko/pkg/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+func optimizeSrc(t *testing.T, src string) *ast.StmtsStmt {
+	stmt, err := parser.ParseSrc(src)
+	assert.NoError(t, err)
+	return Optimize(stmt).(*ast.StmtsStmt)
+}
+
+func optimizeExprSrc(t *testing.T, src string) ast.Expr {
+	return optimizeSrc(t, src).Stmts[0].(*ast.ExprStmt).Expr
+}
+
+func literal(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.NumberExpr:
+		return "number:" + e.Lit
+	case *ast.StringExpr:
+		return "string:" + e.Lit
+	case *ast.ConstExpr:
+		return "const:" + e.Value
+	}
+	return "not a literal"
+}
+
+func TestOptimize_FoldConstants(t *testing.T) {
+	tests := []struct {
+		src      string
+		expected string
+	}{
+		{src: "1 + 2", expected: "number:3"},
+		{src: "1 + 2 * 3", expected: "number:7"},
+		{src: "(1 + 2) * 3", expected: "number:9"},
+		{src: "1.5 + 1.5", expected: "number:3.0"},
+		{src: "1 + 0.5", expected: "number:1.5"},
+		{src: "1 / 2", expected: "number:0.5"},
+		{src: "7 % 3", expected: "number:1"},
+		{src: "2 ** 10", expected: "number:1024"},
+		{src: "1 << 4", expected: "number:16"},
+		{src: "0x10 | 1", expected: "number:17"},
+		{src: "-1", expected: "number:-1"},
+		{src: "-(1 + 2)", expected: "number:-3"},
+		{src: "^1", expected: "number:-2"},
+		{src: "!1", expected: "const:false"},
+		{src: "!false", expected: "const:true"},
+		{src: "1 < 2", expected: "const:true"},
+		{src: "1 == 1.0", expected: "const:true"},
+		{src: `"a" == "b"`, expected: "const:false"},
+		{src: `"a" + "b"`, expected: "string:ab"},
+		{src: `"a" + 1`, expected: "string:a1"},
+		{src: `"ab" * 3`, expected: "string:ababab"},
+		{src: "true ? 1 : 2", expected: "number:1"},
+		{src: "(1 > 2) ? 1 : 2", expected: "number:2"},
+		{src: "1 && 2", expected: "number:2"},
+		{src: "0 || 3", expected: "number:3"},
+		{src: `"abc" || 5`, expected: "string:abc"},
+		{src: `"" && 5`, expected: "string:"},
+		{src: `"false" || 5`, expected: "number:5"},
+		{src: `"0.0" && 5`, expected: "string:0.0"},
+		{src: `"yes" && 5`, expected: "number:5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			assert.Equal(t, tt.expected, literal(optimizeExprSrc(t, tt.src)))
+		})
+	}
+}
+
+func TestOptimize_NotFolded(t *testing.T) {
+	tests := []string{
+		"a + 1",
+		"1 / 0",
+		"1 % 0",
+		`"a" * -1`,
+		`"a" - 1`,
+		`"1" == 1`,
+		"a ? 1 : 2",
+	}
+	for _, src := range tests {
+		t.Run(src, func(t *testing.T) {
+			switch optimizeExprSrc(t, src).(type) {
+			case *ast.BinOpExpr, *ast.TernaryOpExpr:
+			default:
+				t.Fatalf("expression should not be folded: %s", src)
+			}
+		})
+	}
+}
+
+func TestOptimize_ShortCircuit(t *testing.T) {
+	assert.Equal(t, "const:false", literal(optimizeExprSrc(t, "false && a()")))
+	assert.Equal(t, "const:true", literal(optimizeExprSrc(t, "true || a()")))
+	assert.IsType(t, &ast.BinOpExpr{}, optimizeExprSrc(t, "true && a()"))
+}
+
+func TestOptimize_InlineParenExpr(t *testing.T) {
+	e := optimizeExprSrc(t, "((a))")
+	assert.Equal(t, "a", e.(*ast.IdentExpr).Lit)
+}
+
+func TestOptimize_KeepPosition(t *testing.T) {
+	expr := &ast.BinOpExpr{Lhs: &ast.NumberExpr{Lit: "1"}, Operator: "+", Rhs: &ast.NumberExpr{Lit: "2"}}
+	expr.SetPosition(ast.Position{Line: 2, Column: 3})
+	stmt := &ast.ExprStmt{Expr: &ast.ParenExpr{SubExpr: expr}}
+	e := Optimize(stmt).(*ast.ExprStmt).Expr
+	assert.Equal(t, "number:3", literal(e))
+	assert.Equal(t, ast.Position{Line: 2, Column: 3}, e.Position())
+}
+
+func TestOptimize_IfStmt(t *testing.T) {
+	stmts := optimizeSrc(t, "if 1 > 2 { a } else { b }")
+	ifStmt := stmts.Stmts[0].(*ast.IfStmt)
+	assert.Equal(t, "true", ifStmt.If.(*ast.ConstExpr).Value)
+	assert.Equal(t, "b", ifStmt.Then.(*ast.StmtsStmt).Stmts[0].(*ast.ExprStmt).Expr.(*ast.IdentExpr).Lit)
+	assert.Nil(t, ifStmt.Else)
+
+	stmts = optimizeSrc(t, "if 1 < 2 { a } else { b }")
+	ifStmt = stmts.Stmts[0].(*ast.IfStmt)
+	assert.Equal(t, "a", ifStmt.Then.(*ast.StmtsStmt).Stmts[0].(*ast.ExprStmt).Expr.(*ast.IdentExpr).Lit)
+	assert.Nil(t, ifStmt.Else)
+
+	stmts = optimizeSrc(t, "if false { a }; b")
+	assert.Len(t, stmts.Stmts, 1)
+	assert.IsType(t, &ast.ExprStmt{}, stmts.Stmts[0])
+
+	// The last statement provides the value of the block and is kept
+	stmts = optimizeSrc(t, "b; if false { a }")
+	assert.Len(t, stmts.Stmts, 2)
+
+	stmts = optimizeSrc(t, "if a { b } else if false { c } else { d }")
+	elseIf := stmts.Stmts[0].(*ast.IfStmt).Else.(*ast.IfStmt)
+	assert.Equal(t, "d", elseIf.Then.(*ast.StmtsStmt).Stmts[0].(*ast.ExprStmt).Expr.(*ast.IdentExpr).Lit)
+}
+
+func TestOptimize_UnreachableStmts(t *testing.T) {
+	stmts := optimizeSrc(t, "a = 1; return a; a = 2; b = 3")
+	assert.Len(t, stmts.Stmts, 2)
+	assert.IsType(t, &ast.ReturnStmt{}, stmts.Stmts[1])
+
+	stmts = optimizeSrc(t, "func f() { throw 1; a = 2 }")
+	fn := stmts.Stmts[0].(*ast.ExprStmt).Expr.(*ast.FuncExpr)
+	assert.Len(t, fn.Stmt.(*ast.StmtsStmt).Stmts, 1)
+
+	stmts = optimizeSrc(t, "for { break; a = 1 }")
+	loop := stmts.Stmts[0].(*ast.LoopStmt)
+	assert.Len(t, loop.Stmt.(*ast.StmtsStmt).Stmts, 1)
+}
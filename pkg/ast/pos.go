@@ -6,15 +6,50 @@ type Position struct {
 	Column int
 }
 
-// Pos interface provides two functions to get/set the position for expression or statement.
+// Span is the range of source code of an expression or a statement.
+// End is the position following its last character, the offsets are counted in runes from the start of the source.
+type Span struct {
+	File        string // identifier of the source file, empty if the source does not come from a file
+	Start       Position
+	End         Position
+	StartOffset int
+	EndOffset   int
+}
+
+// IsZero returns either or not the span is unknown
+func (s Span) IsZero() bool {
+	return s.End.Line == 0
+}
+
+// Merge returns the span covering both spans, an unknown span is ignored
+func (s Span) Merge(other Span) Span {
+	if s.IsZero() {
+		return other
+	}
+	if other.IsZero() {
+		return s
+	}
+	if other.StartOffset < s.StartOffset {
+		s.Start, s.StartOffset = other.Start, other.StartOffset
+	}
+	if other.EndOffset > s.EndOffset {
+		s.End, s.EndOffset = other.End, other.EndOffset
+	}
+	return s
+}
+
+// Pos interface provides functions to get/set the position and the span for expression or statement.
 type Pos interface {
 	Position() Position
 	SetPosition(Position)
+	Span() Span
+	SetSpan(Span)
 }
 
 // PosImpl provides commonly implementations for Pos.
 type PosImpl struct {
-	pos Position
+	pos  Position
+	span Span
 }
 
 // Position return the position of the expression or statement.
@@ -26,3 +61,13 @@ func (x *PosImpl) Position() Position {
 func (x *PosImpl) SetPosition(pos Position) {
 	x.pos = pos
 }
+
+// Span returns the range of source code of the expression or statement.
+func (x *PosImpl) Span() Span {
+	return x.span
+}
+
+// SetSpan is a function to specify the range of source code of the expression or statement.
+func (x *PosImpl) SetSpan(span Span) {
+	x.span = span
+}
@@ -51,3 +51,24 @@ func TestPosImpl_SetPosition(t *testing.T) {
 		})
 	}
 }
+
+func TestSpan_Merge(t *testing.T) {
+	a := Span{File: "f.ank", Start: Position{1, 1}, End: Position{1, 4}, StartOffset: 0, EndOffset: 3}
+	b := Span{File: "f.ank", Start: Position{2, 3}, End: Position{2, 6}, StartOffset: 6, EndOffset: 9}
+	want := Span{File: "f.ank", Start: Position{1, 1}, End: Position{2, 6}, StartOffset: 0, EndOffset: 9}
+	if got := a.Merge(b); !reflect.DeepEqual(got, want) {
+		t.Errorf("Merge() = %v, want %v", got, want)
+	}
+	if got := b.Merge(a); !reflect.DeepEqual(got, want) {
+		t.Errorf("Merge() = %v, want %v", got, want)
+	}
+	if got := (Span{}).Merge(a); !reflect.DeepEqual(got, a) {
+		t.Errorf("Merge() = %v, want %v", got, a)
+	}
+	if got := a.Merge(Span{}); !reflect.DeepEqual(got, a) {
+		t.Errorf("Merge() = %v, want %v", got, a)
+	}
+	if !(Span{}).IsZero() || a.IsZero() {
+		t.Errorf("IsZero() is wrong")
+	}
+}
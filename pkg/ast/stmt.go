@@ -3,6 +3,7 @@ package ast
 // Stmt provides all of interfaces for statement.
 type Stmt interface {
 	Pos
+	Commented
 	stmt()
 	SetLabel(string)
 	GetLabel() string
@@ -10,8 +11,9 @@ type Stmt interface {
 
 // StmtImpl provide commonly implementations for Stmt..
 type StmtImpl struct {
-	PosImpl // StmtImpl provide Pos() function.
-	Label   string
+	PosImpl      // StmtImpl provide Pos() function.
+	CommentsImpl // StmtImpl provide Comments() function.
+	Label        string
 }
 
 // GetLabel ...
@@ -46,6 +48,20 @@ type DbgStmt struct {
 	TypeData *TypeStruct
 }
 
+// TypeStmt provide statement to declare a type.
+type TypeStmt struct {
+	StmtImpl
+	Name     string
+	TypeData *TypeStruct
+}
+
+// EnumStmt provide enum statement, declaring an enum and its values. ex: enum Color { Red, Green, Blue }
+type EnumStmt struct {
+	StmtImpl
+	Name  string
+	Names []string
+}
+
 // LabelStmt provide label statement.
 type LabelStmt struct {
 	StmtImpl
@@ -62,20 +78,32 @@ type IfStmt struct {
 }
 
 // TryStmt provide "try/catch/finally" statement.
+// The errors are caught by the first of Catches matching them, else by the clause of Var and Catch.
+// That clause is optional when there are Catches, Catch is then nil without it, and never with it.
 type TryStmt struct {
 	StmtImpl
 	Try     Stmt
+	Catches []*CatchStmt
 	Var     string
 	Catch   Stmt
 	Finally Stmt
 }
 
+// CatchStmt provide catch clause of a try statement catching the errors of some kinds, ex: catch e in "not_found", ErrTimeout {}
+type CatchStmt struct {
+	StmtImpl
+	Var   string
+	Kinds []Expr
+	Stmt  Stmt
+}
+
 // ForStmt provide "for in" expression statement.
 type ForStmt struct {
 	StmtImpl
-	Vars  []string
-	Value Expr
-	Stmt  Stmt
+	Vars    []string
+	Value   Expr
+	Stmt    Stmt
+	Pattern Expr // destructuring pattern each value is unpacked into, instead of the variables. ex: for [k, v] in pairs
 }
 
 // CForStmt provide C-style "for (;;)" expression statement.
@@ -118,6 +146,12 @@ type ThrowStmt struct {
 	Expr Expr
 }
 
+// YieldStmt provide "yield" expression statement, it gives a value to the loop iterating a generator.
+type YieldStmt struct {
+	StmtImpl
+	Expr Expr
+}
+
 // ModuleStmt provide "module" expression statement.
 type ModuleStmt struct {
 	StmtImpl
@@ -125,6 +159,51 @@ type ModuleStmt struct {
 	Stmt Stmt
 }
 
+// ExportStmt provide statement to export the symbols declared by a statement of a module, ex: export func f() {}
+type ExportStmt struct {
+	StmtImpl
+	Stmt Stmt
+}
+
+// Names returns the names of the symbols declared by the exported statement, nil if it declares something else
+func (s *ExportStmt) Names() []string {
+	idents := func(exprs []Expr) []string {
+		names := make([]string, 0, len(exprs))
+		for _, expr := range exprs {
+			ident, ok := expr.(*IdentExpr)
+			if !ok {
+				return nil
+			}
+			names = append(names, ident.Lit)
+		}
+		return names
+	}
+	switch stmt := s.Stmt.(type) {
+	case *VarStmt:
+		return stmt.Names
+	case *LetsStmt:
+		if lhss, ok := stmt.Lhss.(*ExprsExpr); ok {
+			return idents(lhss.Exprs)
+		}
+	case *LetMapItemStmt:
+		if lhss, ok := stmt.Lhss.(*ExprsExpr); ok {
+			return idents(lhss.Exprs)
+		}
+	case *ExprStmt:
+		if fn, ok := stmt.Expr.(*FuncExpr); ok && fn.Name != "" && fn.Receiver == nil {
+			return []string{fn.Name}
+		}
+	}
+	return nil
+}
+
+// ImportStmt provide statement to import a script module, ex: import "./lib/util.ank" as util
+type ImportStmt struct {
+	StmtImpl
+	Path string
+	Name string
+}
+
 // SelectStmt provide switch statement.
 type SelectStmt struct {
 	StmtImpl
@@ -163,8 +242,9 @@ type SwitchCaseStmt struct {
 // VarStmt provide statement to let variables in current scope.
 type VarStmt struct {
 	StmtImpl
-	Names []string
-	Exprs []Expr
+	Names   []string
+	Exprs   []Expr
+	Pattern Expr // destructuring pattern the value is unpacked into, instead of the names. ex: var [a, b] = list
 }
 
 // LetsStmt provide multiple statement of let.
@@ -195,3 +275,21 @@ type DeferStmt struct {
 	StmtImpl
 	Expr Expr
 }
+
+// WithKind is the kinds of with statements
+type WithKind int
+
+const (
+	// WithTimeout cancels the block when the timeout expires, ex: with timeout(500ms) {}
+	WithTimeout WithKind = iota
+	// WithCancel cancels the block when a value is received from a channel, or when it is closed, ex: with cancel(ch) {}
+	WithCancel
+)
+
+// WithStmt provide "with" statement, running its block with a context derived from the one of the script
+type WithStmt struct {
+	StmtImpl
+	Kind WithKind
+	Expr Expr // duration of the timeout, or channel canceling the block
+	Stmt Stmt
+}
@@ -0,0 +1,179 @@
+package compiler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+	"github.com/alaingilbert/anko/pkg/ast/astutil"
+	"github.com/alaingilbert/anko/pkg/utils"
+)
+
+const (
+	bundleMagic   = "anko bundle"
+	bundleVersion = 1
+)
+
+var ErrInvalidBundle = errors.New("invalid bundle")
+
+// Bundle packs several compiled scripts in a single file.
+// The manifest lists every module with its size and md5 sum, followed by the compiled modules.
+type Bundle struct {
+	Main    string            // name of the module to run
+	Modules map[string][]byte // compiled modules by name
+}
+
+// NewBundle creates an empty bundle which runs the main module
+func NewBundle(main string) *Bundle {
+	return &Bundle{Main: ModuleName(main), Modules: make(map[string][]byte)}
+}
+
+// ModuleName normalizes a file name the way it is stored in a bundle
+func ModuleName(fileName string) string {
+	return filepath.ToSlash(filepath.Clean(fileName))
+}
+
+// Add adds a compiled module to the bundle
+func (b *Bundle) Add(name string, code []byte) {
+	b.Modules[ModuleName(name)] = code
+}
+
+// Get returns the compiled module for the given file name
+func (b *Bundle) Get(name string) ([]byte, bool) {
+	code, ok := b.Modules[ModuleName(name)]
+	return code, ok
+}
+
+// Names returns the sorted names of the modules in the bundle
+func (b *Bundle) Names() []string {
+	names := make([]string, 0, len(b.Modules))
+	for name := range b.Modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsBundle returns either or not the input is a bundle
+func IsBundle(in []byte) bool {
+	return bytes.HasPrefix(in, []byte(bundleMagic))
+}
+
+// EncodeBundle encodes the bundle
+func EncodeBundle(b *Bundle) ([]byte, error) {
+	if _, ok := b.Modules[b.Main]; !ok {
+		return nil, fmt.Errorf("main module %q not found in bundle", b.Main)
+	}
+	names := b.Names()
+	e := NewEncoder()
+	writeMagic(e, bundleMagic)
+	writeVersion(e, bundleVersion)
+	encodeRawBytes(e, []byte(b.Main))
+	encode(e, int32(len(names)))
+	for _, name := range names {
+		encodeRawBytes(e, []byte(name))
+		encode(e, int32(len(b.Modules[name])))
+		encodeRawBytes(e, []byte(utils.MD5(b.Modules[name])))
+	}
+	for _, name := range names {
+		encode(e, b.Modules[name])
+	}
+	return e.Bytes(), nil
+}
+
+func encodeRawBytes(w *Encoder, by []byte) {
+	encode(w, int32(len(by)), by)
+}
+
+// DecodeBundle decodes a bundle, and verifies the integrity of its modules
+func DecodeBundle(in []byte) (out *Bundle, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			out, err = nil, fmt.Errorf("%w: %v", ErrInvalidBundle, r)
+		}
+	}()
+	if !IsBundle(in) {
+		return nil, ErrInvalidBundle
+	}
+	r := NewDecoder(in[len(bundleMagic):])
+	r.readVersion()
+	type manifestEntry struct {
+		name string
+		size int32
+		sum  string
+	}
+	out = &Bundle{Modules: make(map[string][]byte)}
+	out.Main = string(r.readRawBytes())
+	nbModules := r.readInt32()
+	manifest := make([]manifestEntry, nbModules)
+	for i := range manifest {
+		manifest[i].name = string(r.readRawBytes())
+		manifest[i].size = r.readInt32()
+		manifest[i].sum = string(r.readRawBytes())
+	}
+	for _, entry := range manifest {
+		code := r.readN(entry.size)
+		if utils.MD5(code) != entry.sum {
+			return nil, fmt.Errorf("%w: checksum mismatch for module %q", ErrInvalidBundle, entry.name)
+		}
+		out.Modules[entry.name] = code
+	}
+	if _, ok := out.Modules[out.Main]; !ok {
+		return nil, fmt.Errorf("%w: main module %q not found", ErrInvalidBundle, out.Main)
+	}
+	return out, nil
+}
+
+func (d *Decoder) readRawBytes() []byte {
+	return d.readN(d.decodeInt32())
+}
+
+func (d *Decoder) readN(n int32) []byte {
+	if n < 0 || int(n) > d.Len() {
+		panic("unexpected end of input")
+	}
+	by := make([]byte, n)
+	_, _ = d.Read(by)
+	return by
+}
+
+// LoadedFiles returns the files loaded with a literal path, using load("path/to/file.ank"), by the script
+func LoadedFiles(stmt ast.Stmt) []string {
+	var files []string
+	_ = astutil.Walk(stmt, func(node any, _ int) error {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || call.Name != "load" || call.SubExprs == nil || len(call.SubExprs.Exprs) != 1 {
+			return nil
+		}
+		if str, ok := call.SubExprs.Exprs[0].(*ast.StringExpr); ok {
+			files = append(files, str.Lit)
+		}
+		return nil
+	})
+	return files
+}
+
+// ImportedFiles returns the paths of the script modules imported by the script, with the import statement or
+// with import("path/to/module.ank"). The paths are given as written, they are not resolved.
+func ImportedFiles(stmt ast.Stmt) []string {
+	var files []string
+	_ = astutil.Walk(stmt, func(node any, _ int) error {
+		switch n := node.(type) {
+		case *ast.ImportStmt:
+			files = append(files, n.Path)
+		case *ast.CallExpr:
+			if n.Name != "import" || n.SubExprs == nil || len(n.SubExprs.Exprs) != 1 {
+				return nil
+			}
+			if str, ok := n.SubExprs.Exprs[0].(*ast.StringExpr); ok && (strings.HasSuffix(str.Lit, ".ank") || strings.HasSuffix(str.Lit, ".bnk")) {
+				files = append(files, str.Lit)
+			}
+		}
+		return nil
+	})
+	return files
+}
@@ -0,0 +1,69 @@
+package compiler
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alaingilbert/anko/pkg/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBundle(t *testing.T) {
+	main, err := Compile(`load("lib/x.ank")`, nil)
+	assert.NoError(t, err)
+	lib, err := Compile("a = 1", nil)
+	assert.NoError(t, err)
+
+	b := NewBundle("./main.ank")
+	b.Add("main.ank", main)
+	b.Add("lib/../lib/x.ank", lib)
+	by, err := EncodeBundle(b)
+	assert.NoError(t, err)
+	assert.True(t, IsBundle(by))
+	assert.False(t, IsBundle(main))
+
+	decoded, err := DecodeBundle(by)
+	assert.NoError(t, err)
+	assert.Equal(t, "main.ank", decoded.Main)
+	assert.Equal(t, []string{"lib/x.ank", "main.ank"}, decoded.Names())
+	code, ok := decoded.Get("./lib/x.ank")
+	assert.True(t, ok)
+	assert.Equal(t, lib, code)
+
+	out, err := Disassemble(by)
+	assert.NoError(t, err)
+	assert.Contains(t, out, `bundle main "main.ank"`)
+	assert.Contains(t, out, `module "lib/x.ank"`)
+	assert.Contains(t, out, `string #9 "load"`)
+}
+
+func TestBundle_Invalid(t *testing.T) {
+	_, err := EncodeBundle(NewBundle("main.ank"))
+	assert.Error(t, err)
+
+	_, err = DecodeBundle([]byte("anko bytecode"))
+	assert.True(t, errors.Is(err, ErrInvalidBundle))
+
+	code, _ := Compile("a = 1", nil)
+	b := NewBundle("main.ank")
+	b.Add("main.ank", code)
+	by, _ := EncodeBundle(b)
+	by[len(by)-1]++
+	_, err = DecodeBundle(by)
+	assert.ErrorContains(t, err, "checksum mismatch")
+
+	_, err = DecodeBundle(by[:len(by)-10])
+	assert.True(t, errors.Is(err, ErrInvalidBundle))
+}
+
+func TestLoadedFiles(t *testing.T) {
+	stmt, err := parser.ParseSrc(`load("a.ank"); func f() { load("b.ank") }; load(name); x = 1`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a.ank", "b.ank"}, LoadedFiles(stmt))
+}
+
+func TestImportedFiles(t *testing.T) {
+	stmt, err := parser.ParseSrc(`import "./a.ank" as a; func f() { import("b.bnk") }; import("strings"); import(name); load("c.ank")`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"./a.ank", "b.bnk"}, ImportedFiles(stmt))
+}
@@ -5,8 +5,9 @@ import "fmt"
 type bytecode byte
 
 const (
-	magic   = "anko bytecode"
-	version = 1
+	magic           = "anko bytecode"
+	version         = 3 // 2: metadata, 3: spans of the nodes
+	compilerVersion = "0.0.1"
 
 	NilBytecode            bytecode = 50
 	StmtsStmtBytecode      bytecode = 51 // Stmts
@@ -59,16 +60,38 @@ const (
 	MakeExprBytecode     bytecode = 95
 	MakeTypeExprBytecode bytecode = 96
 	//MakeChanExprBytecode   bytecode = 97
-	ChanExprBytecode       bytecode = 98
-	FuncExprBytecode       bytecode = 99
-	AnonCallExprBytecode   bytecode = 100
-	CallExprBytecode       bytecode = 101
-	IncludeExprBytecode    bytecode = 102
-	BinaryOperatorBytecode bytecode = 103 // "+" "-" "*" "/" "%" "**" "<<" ">>" "==" "!=" ">" ">=" "<" "<=" "|" "||" "&" "&&"
-	ConstExprBytecode      bytecode = 104
-	DbgStmtBytecode        bytecode = 108
-	LabelStmtBytecode      bytecode = 109
-	ExprsExprBytecode      bytecode = 110
+	ChanExprBytecode          bytecode = 98
+	FuncExprBytecode          bytecode = 99
+	AnonCallExprBytecode      bytecode = 100
+	CallExprBytecode          bytecode = 101
+	IncludeExprBytecode       bytecode = 102
+	BinaryOperatorBytecode    bytecode = 103 // "+" "-" "*" "/" "%" "**" "<<" ">>" "==" "!=" ">" ">=" "<" "<=" "|" "||" "&" "&&"
+	ConstExprBytecode         bytecode = 104
+	DbgStmtBytecode           bytecode = 108
+	LabelStmtBytecode         bytecode = 109
+	ExprsExprBytecode         bytecode = 110
+	TypeStmtBytecode          bytecode = 111
+	StructExprBytecode        bytecode = 112
+	MethodExprBytecode        bytecode = 113 // FuncExpr with a receiver
+	InterpExprBytecode        bytecode = 114
+	ImportStmtBytecode        bytecode = 115
+	ExportStmtBytecode        bytecode = 116
+	CatchStmtBytecode         bytecode = 117
+	TryKindsStmtBytecode      bytecode = 118 // TryStmt with catch clauses filtered by kinds
+	OptMemberExprBytecode     bytecode = 119 // MemberExpr of optional chaining
+	OptItemExprBytecode       bytecode = 120 // ItemExpr of optional chaining
+	YieldStmtBytecode         bytecode = 121
+	GeneratorExprBytecode     bytecode = 122 // prefix of a FuncExpr yielding values
+	SpreadExprBytecode        bytecode = 123
+	VarPatternStmtBytecode    bytecode = 124 // VarStmt with a destructuring pattern
+	ForPatternStmtBytecode    bytecode = 125 // ForStmt with a destructuring pattern
+	PatternParamsBytecode     bytecode = 126 // prefix of a FuncExpr with destructuring patterns in its parameters
+	ComprehensionExprBytecode bytecode = 127
+	DurationExprBytecode      bytecode = 128
+	AsyncExprBytecode         bytecode = 129
+	AwaitExprBytecode         bytecode = 130
+	WithStmtBytecode          bytecode = 131
+	EnumStmtBytecode          bytecode = 132
 )
 
 // String ...
@@ -160,6 +183,80 @@ func (b bytecode) String() string {
 		return "AnonCallExprBytecode"
 	case CallExprBytecode:
 		return "CallExprBytecode"
+	case NilBytecode:
+		return "NilBytecode"
+	case SelectBodyStmtBytecode:
+		return "SelectBodyStmtBytecode"
+	case SelectCaseStmtBytecode:
+		return "SelectCaseStmtBytecode"
+	case SwitchCaseStmtBytecode:
+		return "SwitchCaseStmtBytecode"
+	case DeferStmtBytecode:
+		return "DeferStmtBytecode"
+	case NumberExprBytecode:
+		return "NumberExprBytecode"
+	case CloseExprBytecode:
+		return "CloseExprBytecode"
+	case DeleteExprBytecode:
+		return "DeleteExprBytecode"
+	case AssocExprBytecode:
+		return "AssocExprBytecode"
+	case IncludeExprBytecode:
+		return "IncludeExprBytecode"
+	case BinaryOperatorBytecode:
+		return "BinaryOperatorBytecode"
+	case ConstExprBytecode:
+		return "ConstExprBytecode"
+	case DbgStmtBytecode:
+		return "DbgStmtBytecode"
+	case LabelStmtBytecode:
+		return "LabelStmtBytecode"
+	case ExprsExprBytecode:
+		return "ExprsExprBytecode"
+	case TypeStmtBytecode:
+		return "TypeStmtBytecode"
+	case StructExprBytecode:
+		return "StructExprBytecode"
+	case MethodExprBytecode:
+		return "MethodExprBytecode"
+	case InterpExprBytecode:
+		return "InterpExprBytecode"
+	case ImportStmtBytecode:
+		return "ImportStmtBytecode"
+	case ExportStmtBytecode:
+		return "ExportStmtBytecode"
+	case CatchStmtBytecode:
+		return "CatchStmtBytecode"
+	case TryKindsStmtBytecode:
+		return "TryKindsStmtBytecode"
+	case OptMemberExprBytecode:
+		return "OptMemberExprBytecode"
+	case OptItemExprBytecode:
+		return "OptItemExprBytecode"
+	case YieldStmtBytecode:
+		return "YieldStmtBytecode"
+	case GeneratorExprBytecode:
+		return "GeneratorExprBytecode"
+	case SpreadExprBytecode:
+		return "SpreadExprBytecode"
+	case VarPatternStmtBytecode:
+		return "VarPatternStmtBytecode"
+	case ForPatternStmtBytecode:
+		return "ForPatternStmtBytecode"
+	case PatternParamsBytecode:
+		return "PatternParamsBytecode"
+	case ComprehensionExprBytecode:
+		return "ComprehensionExprBytecode"
+	case DurationExprBytecode:
+		return "DurationExprBytecode"
+	case AsyncExprBytecode:
+		return "AsyncExprBytecode"
+	case AwaitExprBytecode:
+		return "AwaitExprBytecode"
+	case WithStmtBytecode:
+		return "WithStmtBytecode"
+	case EnumStmtBytecode:
+		return "EnumStmtBytecode"
 	default:
 		return fmt.Sprintf("INVALID(%d)", byte(b))
 	}
@@ -0,0 +1,88 @@
+package compiler
+
+import (
+	"github.com/alaingilbert/anko/pkg/ast"
+	"github.com/alaingilbert/anko/pkg/ast/astutil"
+)
+
+// The comments are optional, they are written after the body of the bytecode.
+// Each commented node is identified by its index among the statements and expressions, in the order of astutil.Walk.
+
+// commentedNodes returns the statements and expressions of the AST in the order they are walked
+func commentedNodes(stmt ast.Stmt) []ast.Commented {
+	var nodes []ast.Commented
+	_ = astutil.Walk(stmt, func(node any, _ int) error {
+		if n, ok := node.(ast.Commented); ok {
+			nodes = append(nodes, n)
+		}
+		return nil
+	})
+	return nodes
+}
+
+func writeComments(w *Encoder, stmt ast.Stmt) {
+	e := NewEncoder()
+	nodes := commentedNodes(stmt)
+	var nbCommented int32
+	for _, n := range nodes {
+		if n.Comments() != nil {
+			nbCommented++
+		}
+	}
+	encode(e, nbCommented)
+	for i, n := range nodes {
+		if comments := n.Comments(); comments != nil {
+			encode(e, int32(i))
+			encodeCommentGroup(e, comments.Leading)
+			encodeCommentGroup(e, comments.Trailing)
+		}
+	}
+	encodeRawBytes(w, e.Bytes())
+}
+
+func encodeCommentGroup(w *Encoder, group *ast.CommentGroup) {
+	if group == nil {
+		encode(w, int32(0))
+		return
+	}
+	encode(w, int32(len(group.List)))
+	for _, c := range group.List {
+		encodeRawBytes(w, []byte(c.Text))
+		encode(w, int32(c.Position().Line), int32(c.Position().Column), c.Trailing)
+	}
+}
+
+// readComments attaches the comments which follow the body, if any, to the decoded AST.
+// It returns the number of commented nodes.
+func (d *Decoder) readComments(stmt ast.Stmt) int {
+	if d.Len() == 0 {
+		return 0
+	}
+	nodes := commentedNodes(stmt)
+	r := NewDecoder(d.readRawBytes())
+	nbCommented := int(r.decodeInt32())
+	for i := 0; i < nbCommented; i++ {
+		idx := int(r.decodeInt32())
+		if idx < 0 || idx >= len(nodes) {
+			panic("invalid comment node")
+		}
+		nodes[idx].SetComments(&ast.Comments{Leading: r.readCommentGroup(), Trailing: r.readCommentGroup()})
+	}
+	return nbCommented
+}
+
+func (d *Decoder) readCommentGroup() *ast.CommentGroup {
+	nbComments := int(d.decodeInt32())
+	if nbComments == 0 {
+		return nil
+	}
+	group := &ast.CommentGroup{}
+	for i := 0; i < nbComments; i++ {
+		c := &ast.Comment{Text: string(d.readRawBytes())}
+		line, column := d.decodeInt32(), d.decodeInt32()
+		c.SetPosition(ast.Position{Line: int(line), Column: int(column)})
+		c.Trailing = d.readBool()
+		group.List = append(group.List, c)
+	}
+	return group
+}
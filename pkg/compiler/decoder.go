@@ -4,15 +4,22 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/gob"
+	"errors"
 	"fmt"
 
 	"github.com/alaingilbert/anko/pkg/ast"
 )
 
+// ErrInvalidBytecode is returned by DecodeSafe for an input which is not a valid compiled script
+var ErrInvalidBytecode = errors.New("invalid bytecode")
+
 // Decoder ...
 type Decoder struct {
 	*bytes.Reader
-	data []byte
+	data    []byte
+	disasm  *disassembler // when set, every value read is also written to the disassembler
+	depth   int
+	version uint16 // version of the bytecode, known once the metadata is read
 }
 
 func NewDecoder(in []byte) *Decoder {
@@ -30,30 +37,47 @@ func (d *Decoder) readMagic() string {
 	return string(str)
 }
 
-func (d *Decoder) readVersion() {
+func (d *Decoder) readVersion() uint16 {
 	versionBytes := make([]byte, 2)
 	_, err := d.Read(versionBytes)
 	if err != nil {
 		panic(err)
 	}
-	_ = binary.BigEndian.Uint16(versionBytes)
+	return binary.BigEndian.Uint16(versionBytes)
+}
+
+// offset returns the position of the next byte to be read
+func (d *Decoder) offset() int64 {
+	return d.Size() - int64(d.Len())
+}
+
+// trace writes a line in the disassembler if there is one
+func (d *Decoder) trace(offset int64, format string, args ...any) {
+	if d.disasm != nil {
+		d.disasm.writeLine(offset, d.depth, fmt.Sprintf(format, args...))
+	}
 }
 
 func (d *Decoder) readBytecode() bytecode {
+	offset := d.offset()
 	by, err := d.ReadByte()
 	if err != nil {
 		panic(err)
 	}
+	d.trace(offset, "%s", bytecode(by))
 	return bytecode(by)
 }
 
 func (d *Decoder) readString() string {
-	var strIdx int32
-	_ = gob.NewDecoder(d).Decode(&strIdx)
-	var nbChars int32
-	_ = gob.NewDecoder(d).Decode(&nbChars)
+	offset := d.offset()
+	strIdx := d.decodeInt32()
+	nbChars := d.decodeInt32()
 	str := make([]byte, nbChars)
 	str = d.data[strIdx : strIdx+nbChars]
+	if d.disasm != nil {
+		d.disasm.addString(strIdx, string(str))
+	}
+	d.trace(offset, "string #%d %q", strIdx, string(str))
 	return string(str)
 }
 
@@ -91,6 +115,13 @@ func (d *Decoder) readExprArray() []ast.Expr {
 }
 
 func (d *Decoder) readInt32() (val int32) {
+	offset := d.offset()
+	val = d.decodeInt32()
+	d.trace(offset, "int32 %d", val)
+	return val
+}
+
+func (d *Decoder) decodeInt32() (val int32) {
 	if err := gob.NewDecoder(d).Decode(&val); err != nil {
 		panic(err)
 	}
@@ -98,17 +129,25 @@ func (d *Decoder) readInt32() (val int32) {
 }
 
 func (d *Decoder) readFloat64() (val float64) {
+	offset := d.offset()
 	if err := gob.NewDecoder(d).Decode(&val); err != nil {
 		panic(err)
 	}
+	d.trace(offset, "float64 %v", val)
 	return val
 }
 
 func (d *Decoder) readBool() bool {
-	by, _ := d.ReadByte()
+	offset := d.offset()
+	by, err := d.ReadByte()
+	if err != nil {
+		panic(err)
+	}
 	if by == 0 {
+		d.trace(offset, "bool false")
 		return false
 	} else if by == 1 {
+		d.trace(offset, "bool true")
 		return true
 	}
 	panic("failed")
@@ -116,12 +155,37 @@ func (d *Decoder) readBool() bool {
 
 func decodePosImpl(r *Decoder) ast.PosImpl {
 	out := ast.PosImpl{}
-	line := r.readInt32()
-	column := r.readInt32()
+	offset := r.offset()
+	line := r.decodeInt32()
+	column := r.decodeInt32()
+	r.trace(offset, "pos %d:%d", line, column)
 	out.SetPosition(ast.Position{Line: int(line), Column: int(column)})
+	if r.version >= 3 {
+		out.SetSpan(decodeSpan(r))
+	}
 	return out
 }
 
+func decodeSpan(r *Decoder) ast.Span {
+	var span ast.Span
+	offset := r.offset()
+	flags, err := r.ReadByte()
+	if err != nil {
+		panic(err)
+	}
+	if flags&spanKnown == 0 {
+		return span
+	}
+	span.Start = ast.Position{Line: int(r.decodeInt32()), Column: int(r.decodeInt32())}
+	span.End = ast.Position{Line: int(r.decodeInt32()), Column: int(r.decodeInt32())}
+	span.StartOffset, span.EndOffset = int(r.decodeInt32()), int(r.decodeInt32())
+	r.trace(offset, "span %d:%d-%d:%d", span.Start.Line, span.Start.Column, span.End.Line, span.End.Column)
+	if flags&spanFile != 0 {
+		span.File = r.readString()
+	}
+	return span
+}
+
 func decodeExprImpl(r *Decoder) ast.ExprImpl {
 	out := ast.ExprImpl{}
 	out.PosImpl = decodePosImpl(r)
@@ -135,18 +199,32 @@ func decodeStmtImpl(r *Decoder) ast.StmtImpl {
 	return out
 }
 
+// DecodeSafe decodes the compiled script like Decode, but returns an error instead of panicking when the input is invalid
+func DecodeSafe(in []byte) (out ast.Stmt, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			out, err = nil, fmt.Errorf("%w: %v", ErrInvalidBytecode, r)
+		}
+	}()
+	return Decode(in), nil
+}
+
 func Decode(in []byte) ast.Stmt {
 	r := NewDecoder(in)
 	r.readMagic()
-	r.readVersion()
+	r.readMetadata(r.readVersion())
 	startIdx := r.readInt32()
 	r.data = make([]byte, int(startIdx))
 	_, _ = r.Read(r.data)
-	return decodeSingleStmt(r)
+	stmt := decodeSingleStmt(r)
+	r.readComments(stmt)
+	return stmt
 }
 
 func decodeSingleStmt(r *Decoder) ast.Stmt {
 	b := r.readBytecode()
+	r.depth++
+	defer func() { r.depth-- }()
 	switch b {
 	case NilBytecode:
 		return nil
@@ -156,6 +234,8 @@ func decodeSingleStmt(r *Decoder) ast.Stmt {
 		return decodeExprStmt(r)
 	case VarStmtBytecode:
 		return decodeVarStmt(r)
+	case VarPatternStmtBytecode:
+		return decodeVarPatternStmt(r)
 	case LetsStmtBytecode:
 		return decodeLetsStmt(r)
 	case LetMapItemStmtBytecode:
@@ -164,14 +244,22 @@ func decodeSingleStmt(r *Decoder) ast.Stmt {
 		return decodeIfStmt(r)
 	case TryStmtBytecode:
 		return decodeTryStmt(r)
+	case TryKindsStmtBytecode:
+		return decodeTryKindsStmt(r)
+	case CatchStmtBytecode:
+		return decodeCatchStmt(r)
 	case LoopStmtBytecode:
 		return decodeLoopStmt(r)
 	case ForStmtBytecode:
 		return decodeForStmt(r)
+	case ForPatternStmtBytecode:
+		return decodeForPatternStmt(r)
 	case CForStmtBytecode:
 		return decodeCForStmt(r)
 	case ThrowStmtBytecode:
 		return decodeThrowStmt(r)
+	case YieldStmtBytecode:
+		return decodeYieldStmt(r)
 	case ModuleStmtBytecode:
 		return decodeModuleStmt(r)
 	case SelectStmtBytecode:
@@ -188,6 +276,8 @@ func decodeSingleStmt(r *Decoder) ast.Stmt {
 		return decodeGoroutineStmt(r)
 	case DeferStmtBytecode:
 		return decodeDeferStmt(r)
+	case WithStmtBytecode:
+		return decodeWithStmt(r)
 	case BreakStmtBytecode:
 		return decodeBreakStmt(r)
 	case ContinueStmtBytecode:
@@ -198,6 +288,14 @@ func decodeSingleStmt(r *Decoder) ast.Stmt {
 		return decodeDbgStmt(r)
 	case LabelStmtBytecode:
 		return decodeLabelStmt(r)
+	case TypeStmtBytecode:
+		return decodeTypeStmt(r)
+	case EnumStmtBytecode:
+		return decodeEnumStmt(r)
+	case ImportStmtBytecode:
+		return decodeImportStmt(r)
+	case ExportStmtBytecode:
+		return decodeExportStmt(r)
 	default:
 		panic(fmt.Sprintf("invalid (%d)", b))
 	}
@@ -226,6 +324,13 @@ func decodeVarStmt(r *Decoder) *ast.VarStmt {
 	return out
 }
 
+func decodeVarPatternStmt(r *Decoder) *ast.VarStmt {
+	pattern := decodeExpr(r)
+	out := decodeVarStmt(r)
+	out.Pattern = pattern
+	return out
+}
+
 func decodeLetsStmt(r *Decoder) *ast.LetsStmt {
 	out := &ast.LetsStmt{}
 	out.StmtImpl = decodeStmtImpl(r)
@@ -264,6 +369,24 @@ func decodeTryStmt(r *Decoder) *ast.TryStmt {
 	return out
 }
 
+func decodeTryKindsStmt(r *Decoder) *ast.TryStmt {
+	catches := r.readStmtArray()
+	out := decodeTryStmt(r)
+	for _, c := range catches {
+		out.Catches = append(out.Catches, c.(*ast.CatchStmt))
+	}
+	return out
+}
+
+func decodeCatchStmt(r *Decoder) *ast.CatchStmt {
+	out := &ast.CatchStmt{}
+	out.StmtImpl = decodeStmtImpl(r)
+	out.Var = r.readString()
+	out.Kinds = r.readExprArray()
+	out.Stmt = decodeSingleStmt(r)
+	return out
+}
+
 func decodeLoopStmt(r *Decoder) *ast.LoopStmt {
 	out := &ast.LoopStmt{}
 	out.StmtImpl = decodeStmtImpl(r)
@@ -281,6 +404,13 @@ func decodeForStmt(r *Decoder) *ast.ForStmt {
 	return out
 }
 
+func decodeForPatternStmt(r *Decoder) *ast.ForStmt {
+	pattern := decodeExpr(r)
+	out := decodeForStmt(r)
+	out.Pattern = pattern
+	return out
+}
+
 func decodeCForStmt(r *Decoder) *ast.CForStmt {
 	out := &ast.CForStmt{}
 	out.StmtImpl = decodeStmtImpl(r)
@@ -291,6 +421,13 @@ func decodeCForStmt(r *Decoder) *ast.CForStmt {
 	return out
 }
 
+func decodeYieldStmt(r *Decoder) *ast.YieldStmt {
+	out := &ast.YieldStmt{}
+	out.StmtImpl = decodeStmtImpl(r)
+	out.Expr = decodeExpr(r)
+	return out
+}
+
 func decodeThrowStmt(r *Decoder) *ast.ThrowStmt {
 	out := &ast.ThrowStmt{}
 	out.StmtImpl = decodeStmtImpl(r)
@@ -360,6 +497,15 @@ func decodeDeferStmt(r *Decoder) *ast.DeferStmt {
 	return out
 }
 
+func decodeWithStmt(r *Decoder) *ast.WithStmt {
+	out := &ast.WithStmt{}
+	out.StmtImpl = decodeStmtImpl(r)
+	out.Kind = ast.WithKind(r.readInt32())
+	out.Expr = decodeExpr(r)
+	out.Stmt = decodeSingleStmt(r)
+	return out
+}
+
 func decodeBreakStmt(r *Decoder) *ast.BreakStmt {
 	out := &ast.BreakStmt{}
 	out.StmtImpl = decodeStmtImpl(r)
@@ -382,6 +528,37 @@ func decodeDbgStmt(r *Decoder) *ast.DbgStmt {
 	return out
 }
 
+func decodeTypeStmt(r *Decoder) *ast.TypeStmt {
+	out := &ast.TypeStmt{}
+	out.StmtImpl = decodeStmtImpl(r)
+	out.Name = r.readString()
+	out.TypeData = decodeTypeStruct(r)
+	return out
+}
+
+func decodeEnumStmt(r *Decoder) *ast.EnumStmt {
+	out := &ast.EnumStmt{}
+	out.StmtImpl = decodeStmtImpl(r)
+	out.Name = r.readString()
+	out.Names = r.readStringArray()
+	return out
+}
+
+func decodeImportStmt(r *Decoder) *ast.ImportStmt {
+	out := &ast.ImportStmt{}
+	out.StmtImpl = decodeStmtImpl(r)
+	out.Path = r.readString()
+	out.Name = r.readString()
+	return out
+}
+
+func decodeExportStmt(r *Decoder) *ast.ExportStmt {
+	out := &ast.ExportStmt{}
+	out.StmtImpl = decodeStmtImpl(r)
+	out.Stmt = decodeSingleStmt(r)
+	return out
+}
+
 func decodeLabelStmt(r *Decoder) *ast.LabelStmt {
 	out := &ast.LabelStmt{}
 	out.StmtImpl = decodeStmtImpl(r)
@@ -414,6 +591,8 @@ func decodeExprsExpr(r *Decoder) *ast.ExprsExpr {
 
 func decodeExpr(r *Decoder) ast.Expr {
 	b := r.readBytecode()
+	r.depth++
+	defer func() { r.depth-- }()
 	switch b {
 	case NilBytecode:
 		return nil
@@ -427,6 +606,10 @@ func decodeExpr(r *Decoder) ast.Expr {
 		return decodeArrayExpr(r)
 	case MapExprBytecode:
 		return decodeMapExpr(r)
+	case StructExprBytecode:
+		return decodeStructExpr(r)
+	case InterpExprBytecode:
+		return decodeInterpExpr(r)
 	case DerefExprBytecode:
 		return decodeDerefExpr(r)
 	case AddrExprBytecode:
@@ -439,6 +622,10 @@ func decodeExpr(r *Decoder) ast.Expr {
 		return decodeMemberExpr(r)
 	case ItemExprBytecode:
 		return decodeItemExpr(r)
+	case OptMemberExprBytecode:
+		return decodeOptMemberExpr(r)
+	case OptItemExprBytecode:
+		return decodeOptItemExpr(r)
 	case SliceExprBytecode:
 		return decodeSliceExpr(r)
 	case AssocExprBytecode:
@@ -463,6 +650,12 @@ func decodeExpr(r *Decoder) ast.Expr {
 		return decodeChanExpr(r)
 	case FuncExprBytecode:
 		return decodeFuncExpr(r)
+	case MethodExprBytecode:
+		return decodeMethodExpr(r)
+	case GeneratorExprBytecode:
+		return decodeGeneratorExpr(r)
+	case PatternParamsBytecode:
+		return decodePatternParamsExpr(r)
 	case CloseExprBytecode:
 		return decodeCloseExpr(r)
 	case DeleteExprBytecode:
@@ -473,6 +666,16 @@ func decodeExpr(r *Decoder) ast.Expr {
 		return decodeCallExpr(r)
 	case IncludeExprBytecode:
 		return decodeIncludeExpr(r)
+	case SpreadExprBytecode:
+		return decodeSpreadExpr(r)
+	case ComprehensionExprBytecode:
+		return decodeComprehensionExpr(r)
+	case DurationExprBytecode:
+		return decodeDurationExpr(r)
+	case AsyncExprBytecode:
+		return decodeAsyncExpr(r)
+	case AwaitExprBytecode:
+		return decodeAwaitExpr(r)
 	case ExprsExprBytecode:
 		return decodeExprsExpr(r)
 	default:
@@ -501,6 +704,48 @@ func decodeStringExpr(r *Decoder) *ast.StringExpr {
 	return out
 }
 
+func decodeSpreadExpr(r *Decoder) *ast.SpreadExpr {
+	out := &ast.SpreadExpr{}
+	out.ExprImpl = decodeExprImpl(r)
+	out.Expr = decodeExpr(r)
+	return out
+}
+
+func decodeComprehensionExpr(r *Decoder) *ast.ComprehensionExpr {
+	out := &ast.ComprehensionExpr{}
+	out.ExprImpl = decodeExprImpl(r)
+	out.Key = decodeExpr(r)
+	out.Expr = decodeExpr(r)
+	out.Vars = r.readStringArray()
+	out.Pattern = decodeExpr(r)
+	out.Value = decodeExpr(r)
+	out.Cond = decodeExpr(r)
+	return out
+}
+
+func decodeDurationExpr(r *Decoder) *ast.DurationExpr {
+	out := &ast.DurationExpr{}
+	out.ExprImpl = decodeExprImpl(r)
+	out.Lit = r.readString()
+	return out
+}
+
+func decodeAsyncExpr(r *Decoder) *ast.AsyncExpr {
+	out := &ast.AsyncExpr{}
+	out.ExprImpl = decodeExprImpl(r)
+	out.Expr = decodeExpr(r)
+	return out
+}
+
+func decodeAwaitExpr(r *Decoder) *ast.AwaitExpr {
+	out := &ast.AwaitExpr{}
+	out.ExprImpl = decodeExprImpl(r)
+	out.Kind = ast.AwaitKind(r.readInt32())
+	out.Exprs = r.readExprArray()
+	out.Timeout = decodeExpr(r)
+	return out
+}
+
 func decodeArrayExpr(r *Decoder) *ast.ArrayExpr {
 	out := &ast.ArrayExpr{}
 	out.ExprImpl = decodeExprImpl(r)
@@ -518,6 +763,23 @@ func decodeMapExpr(r *Decoder) *ast.MapExpr {
 	return out
 }
 
+func decodeStructExpr(r *Decoder) *ast.StructExpr {
+	out := &ast.StructExpr{}
+	out.ExprImpl = decodeExprImpl(r)
+	out.TypeData = decodeTypeStruct(r)
+	out.Names = r.readStringArray()
+	out.Values = decodeExprsExpr(r)
+	return out
+}
+
+func decodeInterpExpr(r *Decoder) *ast.InterpExpr {
+	out := &ast.InterpExpr{}
+	out.ExprImpl = decodeExprImpl(r)
+	out.Strs = r.readStringArray()
+	out.Exprs = r.readExprArray()
+	return out
+}
+
 func decodeDerefExpr(r *Decoder) *ast.DerefExpr {
 	out := &ast.DerefExpr{}
 	out.ExprImpl = decodeExprImpl(r)
@@ -563,6 +825,18 @@ func decodeItemExpr(r *Decoder) *ast.ItemExpr {
 	return out
 }
 
+func decodeOptMemberExpr(r *Decoder) *ast.MemberExpr {
+	out := decodeMemberExpr(r)
+	out.Optional = true
+	return out
+}
+
+func decodeOptItemExpr(r *Decoder) *ast.ItemExpr {
+	out := decodeItemExpr(r)
+	out.Optional = true
+	return out
+}
+
 func decodeSliceExpr(r *Decoder) *ast.SliceExpr {
 	out := &ast.SliceExpr{}
 	out.ExprImpl = decodeExprImpl(r)
@@ -692,6 +966,28 @@ func decodeFuncExpr(r *Decoder) *ast.FuncExpr {
 	return out
 }
 
+func decodeMethodExpr(r *Decoder) *ast.FuncExpr {
+	receiver := decodeParamExpr(r)
+	out := decodeFuncExpr(r)
+	out.Receiver = receiver
+	return out
+}
+
+func decodeGeneratorExpr(r *Decoder) *ast.FuncExpr {
+	out := decodeExpr(r).(*ast.FuncExpr)
+	out.Generator = true
+	return out
+}
+
+func decodePatternParamsExpr(r *Decoder) *ast.FuncExpr {
+	patterns := r.readExprArray()
+	out := decodeExpr(r).(*ast.FuncExpr)
+	for i, pattern := range patterns {
+		out.Params[i].Pattern = pattern
+	}
+	return out
+}
+
 func (d *Decoder) readParamExprArray() []*ast.ParamExpr {
 	nbElems := d.readInt32()
 	out := make([]*ast.ParamExpr, 0)
@@ -0,0 +1,111 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// disassembler collects the lines written by a Decoder while it decodes the body
+type disassembler struct {
+	body    *bytes.Buffer
+	strings map[stringRef]string
+}
+
+// stringRef is how a string is referenced in the string table
+type stringRef struct {
+	idx    int32
+	length int
+}
+
+func newDisassembler() *disassembler {
+	return &disassembler{body: new(bytes.Buffer), strings: make(map[stringRef]string)}
+}
+
+func (d *disassembler) writeLine(offset int64, depth int, line string) {
+	_, _ = fmt.Fprintf(d.body, "%08x  %s%s\n", offset, strings.Repeat("  ", depth), line)
+}
+
+func (d *disassembler) addString(idx int32, str string) {
+	d.strings[stringRef{idx: idx, length: len(str)}] = str
+}
+
+// Disassemble returns a textual dump of the bytecode.
+// It shows the header, the string table and every opcode with its operands, the offset at which they are encoded,
+// and the position they refer to in the original source.
+func Disassemble(in []byte) (out string, err error) {
+	if IsBundle(in) {
+		return disassembleBundle(in)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid bytecode: %v", r)
+		}
+	}()
+	r := NewDecoder(in)
+	r.disasm = newDisassembler()
+	r.readMagic()
+	metadataOffset := r.offset()
+	metadata := r.readMetadata(r.readVersion())
+	stringsOffset := r.offset()
+	stringsLen := r.decodeInt32()
+	r.data = make([]byte, int(stringsLen))
+	_, _ = r.Read(r.data)
+	bodyOffset := r.offset()
+	stmt := decodeSingleStmt(r)
+	commentsOffset := r.offset()
+	nbCommented := r.readComments(stmt)
+
+	buf := new(bytes.Buffer)
+	_, _ = fmt.Fprintf(buf, "%08x  magic %q\n", 0, magic)
+	_, _ = fmt.Fprintf(buf, "%08x  version %d\n", metadataOffset, metadata.Version)
+	if metadata.Version >= 2 {
+		_, _ = fmt.Fprintf(buf, "%08x  compiler %q\n", metadataOffset+2, metadata.Compiler)
+		_, _ = fmt.Fprintf(buf, "          source %q\n", metadata.SourceHash)
+		_, _ = fmt.Fprintf(buf, "          imports %q\n", metadata.Imports)
+		_, _ = fmt.Fprintf(buf, "          modules %q\n", metadata.Modules)
+		_, _ = fmt.Fprintf(buf, "          host %q\n", metadata.HostIdents)
+	}
+	_, _ = fmt.Fprintf(buf, "%08x  strings %d bytes\n", stringsOffset, stringsLen)
+	refs := make([]stringRef, 0, len(r.disasm.strings))
+	for ref := range r.disasm.strings {
+		refs = append(refs, ref)
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].idx != refs[j].idx {
+			return refs[i].idx < refs[j].idx
+		}
+		return refs[i].length < refs[j].length
+	})
+	for _, ref := range refs {
+		_, _ = fmt.Fprintf(buf, "          #%d %q\n", ref.idx, r.disasm.strings[ref])
+	}
+	_, _ = fmt.Fprintf(buf, "%08x  code %d bytes\n", bodyOffset, commentsOffset-bodyOffset)
+	_, _ = buf.Write(r.disasm.body.Bytes())
+	if commentsOffset < r.Size() {
+		_, _ = fmt.Fprintf(buf, "%08x  comments %d nodes\n", commentsOffset, nbCommented)
+	}
+	return buf.String(), nil
+}
+
+// disassembleBundle returns the manifest of the bundle followed by the dump of each module
+func disassembleBundle(in []byte) (string, error) {
+	bundle, err := DecodeBundle(in)
+	if err != nil {
+		return "", err
+	}
+	buf := new(bytes.Buffer)
+	_, _ = fmt.Fprintf(buf, "bundle main %q\n", bundle.Main)
+	for _, name := range bundle.Names() {
+		_, _ = fmt.Fprintf(buf, "module %q %d bytes\n", name, len(bundle.Modules[name]))
+	}
+	for _, name := range bundle.Names() {
+		out, err := Disassemble(bundle.Modules[name])
+		if err != nil {
+			return "", fmt.Errorf("module %q: %w", name, err)
+		}
+		_, _ = fmt.Fprintf(buf, "\nmodule %q\n%s", name, out)
+	}
+	return buf.String(), nil
+}
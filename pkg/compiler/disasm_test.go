@@ -0,0 +1,35 @@
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDisassemble(t *testing.T) {
+	by, err := Compile("a = 1\nprint(a)", nil)
+	assert.NoError(t, err)
+	out, err := Disassemble(by)
+	assert.NoError(t, err)
+	assert.Contains(t, out, `00000000  magic "anko bytecode"`)
+	assert.Contains(t, out, "0000000d  version 3")
+	assert.Contains(t, out, `          modules []`)
+	assert.Contains(t, out, `          host ["print"]`)
+	assert.Contains(t, out, `          #0 "a"`)
+	assert.Contains(t, out, "00000062  StmtsStmtBytecode\n")
+	assert.Contains(t, out, "    LetsStmtBytecode\n")
+	assert.Contains(t, out, "      pos 1:1\n")
+	assert.Contains(t, out, "      span 1:1-1:6\n")
+	assert.Contains(t, out, "    ExprStmtBytecode\n")
+	assert.Contains(t, out, "      pos 2:1\n")
+	assert.Contains(t, out, `string #3 "print"`)
+}
+
+func TestDisassemble_Invalid(t *testing.T) {
+	_, err := Disassemble([]byte("not bytecode"))
+	assert.Error(t, err)
+
+	by, err := Compile("a = 1", nil)
+	assert.NoError(t, err)
+	_, err = Disassemble(by[:len(by)-5])
+	assert.Error(t, err)
+}
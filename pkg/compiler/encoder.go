@@ -4,54 +4,76 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/gob"
-	"encoding/hex"
 	"fmt"
 	"github.com/alaingilbert/anko/pkg/parser"
 	"github.com/alaingilbert/anko/pkg/utils"
-	"math/rand"
 	"reflect"
 
 	"github.com/alaingilbert/anko/pkg/ast"
+	"github.com/alaingilbert/anko/pkg/ast/obfuscate"
 )
 
-func Compile(src string, obfuscate bool) ([]byte, error) {
+// Compile parses the source and encodes the statements, see EncodeStmtsWithSource
+func Compile(src string, obfuscateCfg *obfuscate.Config) ([]byte, error) {
 	stmt, err := parser.ParseSrc(src)
 	if err != nil {
 		return nil, err
 	}
-	return EncodeStmts(stmt, obfuscate)
+	return EncodeStmtsWithSource(stmt, src, obfuscateCfg)
 }
 
 type Encoder struct {
 	*bytes.Buffer
-	obfuscate  bool
 	strings    map[string]int
 	stringsArr []string
 	stringsIdx int
-	idents     map[string]string
+	version    uint16 // version of the bytecode written
 }
 
-func NewEncoder(obfuscate bool) *Encoder {
+func NewEncoder() *Encoder {
 	e := new(Encoder)
-	e.obfuscate = obfuscate
 	e.Buffer = new(bytes.Buffer)
 	e.strings = make(map[string]int)
-	e.idents = make(map[string]string)
+	e.version = version
 	return e
 }
 
-func EncodeStmts(stmt ast.Stmt, obfuscate bool) ([]byte, error) {
-	b := NewEncoder(obfuscate)
+// EncodeStmts encodes the statements.
+// When obfuscateCfg is not nil, the identifiers defined by the script are renamed first, see obfuscate.Obfuscate.
+func EncodeStmts(stmt ast.Stmt, obfuscateCfg *obfuscate.Config) ([]byte, error) {
+	return encodeStmts(stmt, "", obfuscateCfg, false)
+}
+
+// EncodeStmtsWithSource encodes the statements, and records the md5 sum of their source in the metadata
+func EncodeStmtsWithSource(stmt ast.Stmt, src string, obfuscateCfg *obfuscate.Config) ([]byte, error) {
+	return encodeStmts(stmt, utils.MD5([]byte(src)), obfuscateCfg, false)
+}
+
+// EncodeStmtsWithComments encodes the statements like EncodeStmtsWithSource, and the comments attached to them.
+// Decode attaches the comments back to the AST, so the decompiled source keeps them.
+func EncodeStmtsWithComments(stmt ast.Stmt, src string, obfuscateCfg *obfuscate.Config) ([]byte, error) {
+	return encodeStmts(stmt, utils.MD5([]byte(src)), obfuscateCfg, true)
+}
+
+func encodeStmts(stmt ast.Stmt, sourceHash string, obfuscateCfg *obfuscate.Config, comments bool) ([]byte, error) {
+	if obfuscateCfg != nil {
+		stmt = obfuscate.Obfuscate(stmt, obfuscateCfg)
+	}
+	b := NewEncoder()
 	encodeSingleStmt(b, stmt)
 
-	e := NewEncoder(obfuscate)
+	e := NewEncoder()
 	writeMagic(e, magic)
 	writeVersion(e, version)
+	writeMetadata(e, newMetadata(stmt, sourceHash))
 	encode(e, b.stringsIdx)
 	for _, s := range b.stringsArr {
 		encode(e, []byte(s))
 	}
 	encode(e, b.Bytes())
+	if comments {
+		writeComments(e, stmt)
+	}
 
 	return e.Bytes(), nil
 }
@@ -152,6 +174,35 @@ func encodePosImpl(w *Encoder, p ast.PosImpl) {
 	pos := p.Position()
 	encode(w, int32(pos.Line))
 	encode(w, int32(pos.Column))
+	if w.version >= 3 {
+		encodeSpan(w, p.Span())
+	}
+}
+
+// Flags of the span of a node
+const (
+	spanKnown byte = 1 << iota // the span is known, its positions and offsets follow
+	spanFile                   // the span has a file name, it follows the offsets
+)
+
+// encodeSpan encodes the range of source code of a node, an unknown span is a single byte
+func encodeSpan(w *Encoder, span ast.Span) {
+	var flags byte
+	if !span.IsZero() {
+		flags |= spanKnown
+		if span.File != "" {
+			flags |= spanFile
+		}
+	}
+	encode(w, flags)
+	if flags&spanKnown == 0 {
+		return
+	}
+	encode(w, int32(span.Start.Line), int32(span.Start.Column), int32(span.End.Line), int32(span.End.Column))
+	encode(w, int32(span.StartOffset), int32(span.EndOffset))
+	if flags&spanFile != 0 {
+		encodeString(w, span.File)
+	}
 }
 
 func encodeExprImpl(w *Encoder, i ast.ExprImpl) {
@@ -182,6 +233,8 @@ func encodeSingleStmt(w *Encoder, stmt ast.Stmt) {
 		encodeIfStmt(w, stmt)
 	case *ast.TryStmt:
 		encodeTryStmt(w, stmt)
+	case *ast.CatchStmt:
+		encodeCatchStmt(w, stmt)
 	case *ast.LoopStmt:
 		encodeLoopStmt(w, stmt)
 	case *ast.ForStmt:
@@ -190,6 +243,8 @@ func encodeSingleStmt(w *Encoder, stmt ast.Stmt) {
 		encodeCForStmt(w, stmt)
 	case *ast.ThrowStmt:
 		encodeThrowStmt(w, stmt)
+	case *ast.YieldStmt:
+		encodeYieldStmt(w, stmt)
 	case *ast.ModuleStmt:
 		encodeModuleStmt(w, stmt)
 	case *ast.SelectStmt:
@@ -206,6 +261,8 @@ func encodeSingleStmt(w *Encoder, stmt ast.Stmt) {
 		encodeGoroutineStmt(w, stmt)
 	case *ast.DeferStmt:
 		encodeDeferStmt(w, stmt)
+	case *ast.WithStmt:
+		encodeWithStmt(w, stmt)
 	case *ast.BreakStmt:
 		encodeBreakStmt(w, stmt)
 	case *ast.ContinueStmt:
@@ -216,6 +273,14 @@ func encodeSingleStmt(w *Encoder, stmt ast.Stmt) {
 		encodeDbgStmt(w, stmt)
 	case *ast.LabelStmt:
 		encodeLabelStmt(w, stmt)
+	case *ast.TypeStmt:
+		encodeTypeStmt(w, stmt)
+	case *ast.EnumStmt:
+		encodeEnumStmt(w, stmt)
+	case *ast.ImportStmt:
+		encodeImportStmt(w, stmt)
+	case *ast.ExportStmt:
+		encodeExportStmt(w, stmt)
 	default:
 		panic("failed")
 	}
@@ -234,7 +299,12 @@ func encodeExprStmt(w *Encoder, stmt *ast.ExprStmt) {
 }
 
 func encodeVarStmt(w *Encoder, stmt *ast.VarStmt) {
-	encode(w, VarStmtBytecode)
+	if stmt.Pattern != nil {
+		encode(w, VarPatternStmtBytecode)
+		encodeExpr(w, stmt.Pattern)
+	} else {
+		encode(w, VarStmtBytecode)
+	}
 	encodeStmtImpl(w, stmt.StmtImpl)
 	encodeStringArray(w, stmt.Names)
 	encodeExprArray(w, stmt.Exprs)
@@ -266,7 +336,16 @@ func encodeIfStmt(w *Encoder, stmt *ast.IfStmt) {
 }
 
 func encodeTryStmt(w *Encoder, stmt *ast.TryStmt) {
-	encode(w, TryStmtBytecode)
+	if len(stmt.Catches) > 0 {
+		encode(w, TryKindsStmtBytecode)
+		catches := make([]ast.Stmt, len(stmt.Catches))
+		for i, c := range stmt.Catches {
+			catches[i] = c
+		}
+		encodeStmtArray(w, catches)
+	} else {
+		encode(w, TryStmtBytecode)
+	}
 	encodeStmtImpl(w, stmt.StmtImpl)
 	encodeString(w, stmt.Var)
 	encodeSingleStmt(w, stmt.Try)
@@ -274,6 +353,14 @@ func encodeTryStmt(w *Encoder, stmt *ast.TryStmt) {
 	encodeSingleStmt(w, stmt.Finally)
 }
 
+func encodeCatchStmt(w *Encoder, stmt *ast.CatchStmt) {
+	encode(w, CatchStmtBytecode)
+	encodeStmtImpl(w, stmt.StmtImpl)
+	encodeString(w, stmt.Var)
+	encodeExprArray(w, stmt.Kinds)
+	encodeSingleStmt(w, stmt.Stmt)
+}
+
 func encodeLoopStmt(w *Encoder, stmt *ast.LoopStmt) {
 	encode(w, LoopStmtBytecode)
 	encodeStmtImpl(w, stmt.StmtImpl)
@@ -282,7 +369,12 @@ func encodeLoopStmt(w *Encoder, stmt *ast.LoopStmt) {
 }
 
 func encodeForStmt(w *Encoder, stmt *ast.ForStmt) {
-	encode(w, ForStmtBytecode)
+	if stmt.Pattern != nil {
+		encode(w, ForPatternStmtBytecode)
+		encodeExpr(w, stmt.Pattern)
+	} else {
+		encode(w, ForStmtBytecode)
+	}
 	encodeStmtImpl(w, stmt.StmtImpl)
 	encodeExpr(w, stmt.Value)
 	encodeSingleStmt(w, stmt.Stmt)
@@ -304,6 +396,12 @@ func encodeThrowStmt(w *Encoder, stmt *ast.ThrowStmt) {
 	encodeExpr(w, stmt.Expr)
 }
 
+func encodeYieldStmt(w *Encoder, stmt *ast.YieldStmt) {
+	encode(w, YieldStmtBytecode)
+	encodeStmtImpl(w, stmt.StmtImpl)
+	encodeExpr(w, stmt.Expr)
+}
+
 func encodeModuleStmt(w *Encoder, stmt *ast.ModuleStmt) {
 	encode(w, ModuleStmtBytecode)
 	encodeStmtImpl(w, stmt.StmtImpl)
@@ -358,6 +456,14 @@ func encodeDeferStmt(w *Encoder, stmt *ast.DeferStmt) {
 	encodeExpr(w, stmt.Expr)
 }
 
+func encodeWithStmt(w *Encoder, stmt *ast.WithStmt) {
+	encode(w, WithStmtBytecode)
+	encodeStmtImpl(w, stmt.StmtImpl)
+	encode(w, int(stmt.Kind))
+	encodeExpr(w, stmt.Expr)
+	encodeSingleStmt(w, stmt.Stmt)
+}
+
 func encodeBreakStmt(w *Encoder, stmt *ast.BreakStmt) {
 	encode(w, BreakStmtBytecode)
 	encodeStmtImpl(w, stmt.StmtImpl)
@@ -383,6 +489,33 @@ func encodeLabelStmt(w *Encoder, stmt *ast.LabelStmt) {
 	encodeSingleStmt(w, stmt.Stmt)
 }
 
+func encodeTypeStmt(w *Encoder, stmt *ast.TypeStmt) {
+	encode(w, TypeStmtBytecode)
+	encodeStmtImpl(w, stmt.StmtImpl)
+	encodeString(w, stmt.Name)
+	encodeTypeStruct(w, stmt.TypeData)
+}
+
+func encodeEnumStmt(w *Encoder, stmt *ast.EnumStmt) {
+	encode(w, EnumStmtBytecode)
+	encodeStmtImpl(w, stmt.StmtImpl)
+	encodeString(w, stmt.Name)
+	encodeStringArray(w, stmt.Names)
+}
+
+func encodeImportStmt(w *Encoder, stmt *ast.ImportStmt) {
+	encode(w, ImportStmtBytecode)
+	encodeStmtImpl(w, stmt.StmtImpl)
+	encodeString(w, stmt.Path)
+	encodeString(w, stmt.Name)
+}
+
+func encodeExportStmt(w *Encoder, stmt *ast.ExportStmt) {
+	encode(w, ExportStmtBytecode)
+	encodeStmtImpl(w, stmt.StmtImpl)
+	encodeSingleStmt(w, stmt.Stmt)
+}
+
 func encodeDbgStmt(w *Encoder, expr *ast.DbgStmt) {
 	encode(w, DbgStmtBytecode)
 	encodeStmtImpl(w, expr.StmtImpl)
@@ -405,6 +538,10 @@ func encodeExpr(w *Encoder, expr ast.Expr) {
 		encodeArrayExpr(w, expr)
 	case *ast.MapExpr:
 		encodeMapExpr(w, expr)
+	case *ast.StructExpr:
+		encodeStructExpr(w, expr)
+	case *ast.InterpExpr:
+		encodeInterpExpr(w, expr)
 	case *ast.DerefExpr:
 		encodeDerefExpr(w, expr)
 	case *ast.AddrExpr:
@@ -451,6 +588,16 @@ func encodeExpr(w *Encoder, expr ast.Expr) {
 		encodeDeleteExpr(w, expr)
 	case *ast.IncludeExpr:
 		encodeIncludeExpr(w, expr)
+	case *ast.SpreadExpr:
+		encodeSpreadExpr(w, expr)
+	case *ast.ComprehensionExpr:
+		encodeComprehensionExpr(w, expr)
+	case *ast.DurationExpr:
+		encodeDurationExpr(w, expr)
+	case *ast.AsyncExpr:
+		encodeAsyncExpr(w, expr)
+	case *ast.AwaitExpr:
+		encodeAwaitExpr(w, expr)
 	case *ast.ExprsExpr:
 		encodeExprsExpr(w, expr)
 	default:
@@ -465,25 +612,10 @@ func encodeNumberExpr(w *Encoder, expr *ast.NumberExpr) {
 	encodeString(w, expr.Lit)
 }
 
-func generateToken1() string {
-	b := make([]byte, 6)
-	rand.Read(b)
-	return hex.EncodeToString(b)
-}
-
 func encodeIdentExpr(w *Encoder, expr *ast.IdentExpr) {
-	lit := expr.Lit
-	if w.obfuscate {
-		if id, ok := w.idents[expr.Lit]; ok {
-			lit = id
-		} else {
-			lit = "id_" + generateToken1()
-			w.idents[expr.Lit] = lit
-		}
-	}
 	encode(w, IdentExprBytecode)
 	encodeExprImpl(w, expr.ExprImpl)
-	encodeString(w, lit)
+	encodeString(w, expr.Lit)
 }
 
 func encodeStringExpr(w *Encoder, expr *ast.StringExpr) {
@@ -492,6 +624,43 @@ func encodeStringExpr(w *Encoder, expr *ast.StringExpr) {
 	encodeString(w, expr.Lit)
 }
 
+func encodeSpreadExpr(w *Encoder, expr *ast.SpreadExpr) {
+	encode(w, SpreadExprBytecode)
+	encodeExprImpl(w, expr.ExprImpl)
+	encodeExpr(w, expr.Expr)
+}
+
+func encodeDurationExpr(w *Encoder, expr *ast.DurationExpr) {
+	encode(w, DurationExprBytecode)
+	encodeExprImpl(w, expr.ExprImpl)
+	encodeString(w, expr.Lit)
+}
+
+func encodeAsyncExpr(w *Encoder, expr *ast.AsyncExpr) {
+	encode(w, AsyncExprBytecode)
+	encodeExprImpl(w, expr.ExprImpl)
+	encodeExpr(w, expr.Expr)
+}
+
+func encodeAwaitExpr(w *Encoder, expr *ast.AwaitExpr) {
+	encode(w, AwaitExprBytecode)
+	encodeExprImpl(w, expr.ExprImpl)
+	encode(w, int(expr.Kind))
+	encodeExprArray(w, expr.Exprs)
+	encodeExpr(w, expr.Timeout)
+}
+
+func encodeComprehensionExpr(w *Encoder, expr *ast.ComprehensionExpr) {
+	encode(w, ComprehensionExprBytecode)
+	encodeExprImpl(w, expr.ExprImpl)
+	encodeExpr(w, expr.Key)
+	encodeExpr(w, expr.Expr)
+	encodeStringArray(w, expr.Vars)
+	encodeExpr(w, expr.Pattern)
+	encodeExpr(w, expr.Value)
+	encodeExpr(w, expr.Cond)
+}
+
 func encodeArrayExpr(w *Encoder, expr *ast.ArrayExpr) {
 	encode(w, ArrayExprBytecode)
 	encodeExprImpl(w, expr.ExprImpl)
@@ -507,6 +676,21 @@ func encodeMapExpr(w *Encoder, expr *ast.MapExpr) {
 	encodeTypeStruct(w, expr.TypeData)
 }
 
+func encodeStructExpr(w *Encoder, expr *ast.StructExpr) {
+	encode(w, StructExprBytecode)
+	encodeExprImpl(w, expr.ExprImpl)
+	encodeTypeStruct(w, expr.TypeData)
+	encodeStringArray(w, expr.Names)
+	encodeExprsExprHelper(w, expr.Values)
+}
+
+func encodeInterpExpr(w *Encoder, expr *ast.InterpExpr) {
+	encode(w, InterpExprBytecode)
+	encodeExprImpl(w, expr.ExprImpl)
+	encodeStringArray(w, expr.Strs)
+	encodeExprArray(w, expr.Exprs)
+}
+
 func encodeDerefExpr(w *Encoder, expr *ast.DerefExpr) {
 	encode(w, DerefExprBytecode)
 	encodeExprImpl(w, expr.ExprImpl)
@@ -533,14 +717,22 @@ func encodeParenExpr(w *Encoder, expr *ast.ParenExpr) {
 }
 
 func encodeMemberExpr(w *Encoder, expr *ast.MemberExpr) {
-	encode(w, MemberExprBytecode)
+	if expr.Optional {
+		encode(w, OptMemberExprBytecode)
+	} else {
+		encode(w, MemberExprBytecode)
+	}
 	encodeExprImpl(w, expr.ExprImpl)
 	encodeString(w, expr.Name)
 	encodeExpr(w, expr.Expr)
 }
 
 func encodeItemExpr(w *Encoder, expr *ast.ItemExpr) {
-	encode(w, ItemExprBytecode)
+	if expr.Optional {
+		encode(w, OptItemExprBytecode)
+	} else {
+		encode(w, ItemExprBytecode)
+	}
 	encodeExprImpl(w, expr.ExprImpl)
 	encodeExpr(w, expr.Index)
 	encodeExpr(w, expr.Value)
@@ -650,7 +842,19 @@ func encodeChanExpr(w *Encoder, expr *ast.ChanExpr) {
 }
 
 func encodeFuncExpr(w *Encoder, expr *ast.FuncExpr) {
-	encode(w, FuncExprBytecode)
+	if patterns, ok := paramPatterns(expr.Params); ok {
+		encode(w, PatternParamsBytecode)
+		encodeExprArray(w, patterns)
+	}
+	if expr.Generator {
+		encode(w, GeneratorExprBytecode)
+	}
+	if expr.Receiver != nil {
+		encode(w, MethodExprBytecode)
+		encodeParamExpr(w, expr.Receiver)
+	} else {
+		encode(w, FuncExprBytecode)
+	}
 	encodeExprImpl(w, expr.ExprImpl)
 	encodeString(w, expr.Name)
 	encodeBool(w, expr.VarArg)
@@ -659,6 +863,17 @@ func encodeFuncExpr(w *Encoder, expr *ast.FuncExpr) {
 	encodeSingleStmt(w, expr.Stmt)
 }
 
+// paramPatterns returns the destructuring patterns of the parameters, nil for the named ones, and true if there is one.
+func paramPatterns(params []*ast.ParamExpr) ([]ast.Expr, bool) {
+	patterns := make([]ast.Expr, len(params))
+	found := false
+	for i, param := range params {
+		patterns[i] = param.Pattern
+		found = found || param.Pattern != nil
+	}
+	return patterns, found
+}
+
 func encodeParamExprArray(w *Encoder, exprs []*ast.ParamExpr) {
 	encode(w, int32(len(exprs)))
 	for _, e := range exprs {
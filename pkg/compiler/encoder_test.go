@@ -1,12 +1,17 @@
 package compiler
 
 import (
+	"github.com/alaingilbert/anko/pkg/ast"
+	"github.com/alaingilbert/anko/pkg/ast/obfuscate"
+	"github.com/alaingilbert/anko/pkg/decompiler"
+	"github.com/alaingilbert/anko/pkg/parser"
 	"github.com/stretchr/testify/assert"
+	"strings"
 	"testing"
 )
 
 func TestCompile1(t *testing.T) {
-	by, err := Compile("a = 1", false)
+	by, err := Compile("a = 1", nil)
 	assert.NoError(t, err)
 	Decode(by)
 	//by, err = Compile(`"a" in ["a"]`)
@@ -27,3 +32,46 @@ func TestCompile1(t *testing.T) {
 	////pprint(stmts2)
 	//assert.True(t, reflect.DeepEqual(stmts1, stmts2))
 }
+
+func TestCompile_Obfuscate(t *testing.T) {
+	by, err := Compile("a = 1\nb = a + 1", &obfuscate.Config{Preserve: []string{"b"}})
+	assert.NoError(t, err)
+	out, err := decompiler.Decompile(Decode(by))
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(out, "id_"))
+	assert.Contains(t, out, "\nb = id_")
+}
+
+func TestEncodeStmtsWithComments(t *testing.T) {
+	src := "# doc of a\na = 1 # one\nfunc f() {\n    b = 2\n    # end of f\n}"
+	stmt, err := parser.ParseSrc(src)
+	assert.NoError(t, err)
+
+	by, err := EncodeStmtsWithSource(stmt, src, nil)
+	assert.NoError(t, err)
+	out, err := decompiler.Decompile(Decode(by))
+	assert.NoError(t, err)
+	assert.Equal(t, "a = 1\nfunc f() {\n    b = 2\n}\n", out)
+
+	by, err = EncodeStmtsWithComments(stmt, src, nil)
+	assert.NoError(t, err)
+	out, err = decompiler.Decompile(Decode(by))
+	assert.NoError(t, err)
+	assert.Equal(t, "# doc of a\na = 1 # one\nfunc f() {\n    b = 2\n    # end of f\n}\n", out)
+
+	dump, err := Disassemble(by)
+	assert.NoError(t, err)
+	assert.Contains(t, dump, "comments 2 nodes\n")
+}
+
+func TestEncodeStmtsSpans(t *testing.T) {
+	stmt, err := parser.ParseFile("main.ank", "a = 1\nb = a + 2")
+	assert.NoError(t, err)
+	by, err := EncodeStmts(stmt, nil)
+	assert.NoError(t, err)
+	decoded := Decode(by)
+	assert.Equal(t, stmt.Span(), decoded.Span())
+	stmts := decoded.(*ast.StmtsStmt).Stmts
+	assert.Equal(t, stmt.(*ast.StmtsStmt).Stmts[1].Span(), stmts[1].Span())
+	assert.Equal(t, "main.ank", stmts[1].Span().File)
+}
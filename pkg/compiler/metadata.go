@@ -0,0 +1,112 @@
+package compiler
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+	"github.com/alaingilbert/anko/pkg/ast/astutil"
+	"github.com/alaingilbert/anko/pkg/utils"
+)
+
+// Metadata is stored in the header of the bytecode, it can be read without decoding the body
+type Metadata struct {
+	Version    uint16   // bytecode format version
+	Compiler   string   // version of the compiler that produced the bytecode
+	SourceHash string   // md5 sum of the source, empty if the source was not provided
+	Imports    []string // packages imported with import("name")
+	Modules    []string // script modules imported with import("path") or the import statement
+	HostIdents []string // identifiers the script reads without defining them first, they are expected from the host env
+}
+
+// ReadMetadata reads the metadata from the header of the bytecode
+func ReadMetadata(in []byte) (out *Metadata, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			out, err = nil, fmt.Errorf("invalid bytecode: %v", r)
+		}
+	}()
+	r := NewDecoder(in)
+	r.readMagic()
+	return r.readMetadata(r.readVersion()), nil
+}
+
+func (d *Decoder) readMetadata(ver uint16) *Metadata {
+	d.version = ver
+	out := &Metadata{Version: ver}
+	if ver < 2 {
+		return out
+	}
+	metadata := NewDecoder(d.readRawBytes())
+	out.Compiler = string(metadata.readRawBytes())
+	out.SourceHash = string(metadata.readRawBytes())
+	out.Imports = metadata.readRawStringArray()
+	out.Modules = metadata.readRawStringArray()
+	out.HostIdents = metadata.readRawStringArray()
+	return out
+}
+
+func (d *Decoder) readRawStringArray() []string {
+	out := make([]string, d.readInt32())
+	for i := range out {
+		out[i] = string(d.readRawBytes())
+	}
+	return out
+}
+
+func writeMetadata(w *Encoder, m *Metadata) {
+	e := NewEncoder()
+	encodeRawBytes(e, []byte(m.Compiler))
+	encodeRawBytes(e, []byte(m.SourceHash))
+	encodeRawStringArray(e, m.Imports)
+	encodeRawStringArray(e, m.Modules)
+	encodeRawStringArray(e, m.HostIdents)
+	encodeRawBytes(w, e.Bytes())
+}
+
+func encodeRawStringArray(w *Encoder, strs []string) {
+	encode(w, int32(len(strs)))
+	for _, str := range strs {
+		encodeRawBytes(w, []byte(str))
+	}
+}
+
+// newMetadata analyzes the script to find the packages and modules it imports and the identifiers it expects from the host
+func newMetadata(stmt ast.Stmt, sourceHash string) *Metadata {
+	imports := make(map[string]struct{})
+	modules := make(map[string]struct{})
+	_ = astutil.Walk(stmt, func(node any, _ int) error {
+		switch n := node.(type) {
+		case *ast.CallExpr:
+			if n.Name == "import" && n.SubExprs != nil && len(n.SubExprs.Exprs) == 1 {
+				if str, ok := n.SubExprs.Exprs[0].(*ast.StringExpr); ok {
+					if utils.IsModulePath(str.Lit) {
+						modules[str.Lit] = struct{}{}
+					} else {
+						imports[str.Lit] = struct{}{}
+					}
+				}
+			}
+		case *ast.ImportStmt:
+			modules[n.Path] = struct{}{}
+		}
+		return nil
+	})
+	return &Metadata{
+		Version:    version,
+		Compiler:   compilerVersion,
+		SourceHash: sourceHash,
+		Imports:    sortedKeys(imports),
+		Modules:    sortedKeys(modules),
+		HostIdents: astutil.HostIdents(stmt),
+	}
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
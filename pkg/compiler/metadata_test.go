@@ -0,0 +1,82 @@
+package compiler
+
+import (
+	"testing"
+
+	"github.com/alaingilbert/anko/pkg/parser"
+	"github.com/alaingilbert/anko/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadMetadata(t *testing.T) {
+	src := `fmt = import("fmt")
+func add(a, b) { return a + b }
+for i in range(3) { fmt.Println(add(i, x)) }
+try { throw 1 } catch e { println(e) }`
+	by, err := Compile(src, nil)
+	assert.NoError(t, err)
+	m, err := ReadMetadata(by)
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(version), m.Version)
+	assert.Equal(t, compilerVersion, m.Compiler)
+	assert.Equal(t, utils.MD5([]byte(src)), m.SourceHash)
+	assert.Equal(t, []string{"fmt"}, m.Imports)
+	assert.Equal(t, []string{"import", "println", "range", "x"}, m.HostIdents)
+
+	stmt, err := parser.ParseSrc(src)
+	assert.NoError(t, err)
+	by, err = EncodeStmts(stmt, nil)
+	assert.NoError(t, err)
+	m, err = ReadMetadata(by)
+	assert.NoError(t, err)
+	assert.Equal(t, "", m.SourceHash)
+	assert.Equal(t, []string{"fmt"}, m.Imports)
+
+	// The name of an imported script module is defined by the script
+	by, err = Compile(`import "./lib.ank" as lib; lib.F(y)`, nil)
+	assert.NoError(t, err)
+	m, err = ReadMetadata(by)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"y"}, m.HostIdents)
+	assert.Equal(t, []string{"./lib.ank"}, m.Modules)
+	assert.Empty(t, m.Imports)
+
+	// Script modules are not mixed with the packages
+	by, err = Compile(`http = import("net/http"); util = import("./lib/util.ank")`, nil)
+	assert.NoError(t, err)
+	m, err = ReadMetadata(by)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"net/http"}, m.Imports)
+	assert.Equal(t, []string{"./lib/util.ank"}, m.Modules)
+
+	// An identifier read before the script assigns it is expected from the host
+	by, err = Compile("count = count + 1\n[a, b] = [b, 1]\nvar c = d\nd = 1\nf = func() { return e + d }\ne = c", nil)
+	assert.NoError(t, err)
+	m, err = ReadMetadata(by)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b", "count", "d"}, m.HostIdents)
+
+	_, err = ReadMetadata([]byte("not bytecode"))
+	assert.Error(t, err)
+}
+
+func TestReadMetadata_Version1(t *testing.T) {
+	stmt, err := parser.ParseSrc("a = 1; a + 1")
+	assert.NoError(t, err)
+	b := NewEncoder()
+	b.version = 1
+	encodeSingleStmt(b, stmt)
+	e := NewEncoder()
+	writeMagic(e, magic)
+	writeVersion(e, 1)
+	encode(e, b.stringsIdx)
+	for _, s := range b.stringsArr {
+		encode(e, []byte(s))
+	}
+	encode(e, b.Bytes())
+
+	m, err := ReadMetadata(e.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, &Metadata{Version: 1}, m)
+	assert.NotNil(t, Decode(e.Bytes()))
+}
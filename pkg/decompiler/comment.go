@@ -0,0 +1,159 @@
+package decompiler
+
+import (
+	"strings"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+	"github.com/alaingilbert/anko/pkg/ast/astutil"
+)
+
+// CommentHooks returns the hooks writing the comments attached to the AST, the ones Decompile uses.
+// The comments attached to the expressions of a statement are written before it, with its leading comments,
+// except the ones of the elements of a map literal: the map is then written one element per line, with their comments.
+// If blankBefore is not nil, a blank line is written before the statements and the comments starting on a line
+// for which it returns true, so the blank lines of the source can be kept.
+func CommentHooks(blankBefore func(line int) bool) Hooks {
+	c := &commentWriter{blankBefore: blankBefore}
+	return Hooks{
+		BeforeStmt: c.beforeStmt,
+		AfterStmt:  c.afterStmt,
+		BlockEnd:   c.blockEnd,
+		elements:   true,
+	}
+}
+
+type commentWriter struct {
+	blankBefore func(line int) bool
+	indents     []string // indents of the statements being written
+}
+
+func (c *commentWriter) beforeStmt(stmt ast.Stmt, indent string) string {
+	c.indents = append(c.indents, indent)
+	var sb strings.Builder
+	if comments := stmt.Comments(); comments != nil {
+		c.writeComments(&sb, comments.Leading, indent)
+	}
+	if c.blank(startLine(stmt)) {
+		sb.WriteString("\n")
+	}
+	elements := mapElements(stmt)
+	_ = astutil.Walk(stmt, func(node any, deep int) error {
+		if expr, ok := node.(ast.Expr); ok && deep == 1 && !elements[expr] && expr.Comments() != nil {
+			c.writeComments(&sb, expr.Comments().Leading, indent)
+			c.writeComments(&sb, expr.Comments().Trailing, indent)
+		}
+		return nil
+	})
+	return sb.String()
+}
+
+func (c *commentWriter) afterStmt(stmt ast.Stmt, multiline bool) string {
+	indent := c.indents[len(c.indents)-1]
+	c.indents = c.indents[:len(c.indents)-1]
+	comments := stmt.Comments()
+	if comments == nil || comments.Trailing == nil {
+		return ""
+	}
+	var sb strings.Builder
+	c.writeTrailing(&sb, comments.Trailing.List, indent, !multiline)
+	return sb.String()
+}
+
+// blockEnd writes the comments of an empty block or clause, which have no statement to be attached to
+func (c *commentWriter) blockEnd(block ast.Stmt, indent string) string {
+	stmts, ok := block.(*ast.StmtsStmt)
+	if !ok || len(stmts.Stmts) > 0 || stmts.Comments() == nil {
+		return ""
+	}
+	var sb strings.Builder
+	c.writeComments(&sb, stmts.Comments().Leading, indent)
+	c.writeComments(&sb, stmts.Comments().Trailing, indent)
+	return sb.String()
+}
+
+// writeComments writes the comments of the group, each on its own line
+func (c *commentWriter) writeComments(sb *strings.Builder, group *ast.CommentGroup, indent string) {
+	if group == nil {
+		return
+	}
+	for _, comment := range group.List {
+		if c.blank(comment.Position().Line) {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(indent + comment.Text + "\n")
+	}
+}
+
+// writeTrailing writes trailing comments after the text of a node. The first one stays on the line of the node if it
+// followed the node on its line in the source and sameLine is true, the other ones are written on their own lines.
+func (c *commentWriter) writeTrailing(sb *strings.Builder, list []*ast.Comment, indent string, sameLine bool) {
+	if sameLine && len(list) > 0 && list[0].Trailing {
+		sb.WriteString(" " + list[0].Text)
+		list = list[1:]
+	}
+	for _, comment := range list {
+		if c.blank(comment.Position().Line) {
+			sb.WriteString("\n")
+		}
+		sb.WriteString("\n" + indent + comment.Text)
+	}
+}
+
+func (c *commentWriter) blank(line int) bool {
+	return c.blankBefore != nil && line > 0 && c.blankBefore(line)
+}
+
+// startLine returns the line at which the statement starts in the source, 0 if it is not known
+func startLine(stmt ast.Stmt) int {
+	if line := stmt.Span().Start.Line; line > 0 {
+		return line
+	}
+	return stmt.Position().Line
+}
+
+// mapElements returns the keys and the values of the map literals of the statement which are written one element per
+// line, their comments are written with them
+func mapElements(stmt ast.Stmt) map[ast.Expr]bool {
+	elements := make(map[ast.Expr]bool)
+	_ = astutil.Walk(stmt, func(node any, _ int) error {
+		if e, ok := node.(*ast.MapExpr); ok && hasElementComments(e) {
+			for i, k := range e.Keys.Exprs {
+				elements[k] = true
+				if v := e.Values.Exprs[i]; v != nil {
+					elements[v] = true
+				}
+			}
+		}
+		return nil
+	})
+	return elements
+}
+
+// hasElementComments returns true if comments are attached to a key or a value of the map literal
+func hasElementComments(e *ast.MapExpr) bool {
+	for i, k := range e.Keys.Exprs {
+		if k.Comments() != nil {
+			return true
+		}
+		if v := e.Values.Exprs[i]; v != nil && v.Comments() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// elementComments returns the leading and the trailing comments of an element of a map literal
+func elementComments(key, value ast.Expr) (leading, trailing []*ast.Comment) {
+	for _, e := range []ast.Expr{key, value} {
+		if e == nil || e.Comments() == nil {
+			continue
+		}
+		if group := e.Comments().Leading; group != nil {
+			leading = append(leading, group.List...)
+		}
+		if group := e.Comments().Trailing; group != nil {
+			trailing = append(trailing, group.List...)
+		}
+	}
+	return leading, trailing
+}
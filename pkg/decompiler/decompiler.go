@@ -3,306 +3,981 @@ package decompiler
 import (
 	"bytes"
 	"fmt"
-	"github.com/alaingilbert/anko/pkg/ast"
-	"reflect"
 	"strings"
+
+	"github.com/alaingilbert/anko/pkg/ast"
 )
 
-func Decompile(stmt ast.Stmt) string {
-	buf := new(bytes.Buffer)
-	decompileStmt(buf, stmt, 0)
-	return buf.String()
+// Hooks let the caller write text around the statements while decompiling, such as comments.
+// Any of them can be nil.
+type Hooks struct {
+	BeforeStmt func(stmt ast.Stmt, indent string) string  // lines written before a statement or a case clause
+	AfterStmt  func(stmt ast.Stmt, multiline bool) string // text appended to the last line of a statement or a case
+	BlockEnd   func(block ast.Stmt, indent string) string // lines written at the end of a block or of a clause
+	elements   bool                                       // write the comments of the elements of the map literals
+}
+
+// printer is the buffer the source is written to
+type printer struct {
+	*bytes.Buffer
+	hooks   Hooks
+	clause  bool // writing the clause of an if, for or switch statement
+	pattern bool // writing a destructuring pattern, in which {name} is short for {"name": name}
+}
+
+// Decompile returns the source code of the AST, with the comments attached to it.
+// Parsing the output gives back the same AST, except for the positions and some parentheses which are added
+// around nested operations, so they keep the same meaning whatever the precedence of their operators.
+func Decompile(stmt ast.Stmt) (string, error) {
+	return DecompileWithHooks(stmt, CommentHooks(nil))
+}
+
+// DecompileWithHooks returns the source code of the AST, calling the hooks around the statements.
+// The comments attached to the AST are not written, the hooks can do it.
+func DecompileWithHooks(stmt ast.Stmt, hooks Hooks) (out string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			out, err = "", fmt.Errorf("decompile: %v", r)
+		}
+	}()
+	buf := &printer{Buffer: new(bytes.Buffer), hooks: hooks}
+	if stmts, ok := stmt.(*ast.StmtsStmt); ok {
+		decompileStmts(buf, stmts, 0)
+	} else if stmt != nil {
+		decompileListedStmt(buf, stmt, 0)
+	}
+	return buf.String(), nil
+}
+
+func indent(deep int) string {
+	return strings.Repeat(" ", 4*deep)
+}
+
+func decompileStmts(w *printer, s *ast.StmtsStmt, deep int) {
+	for _, stmt := range s.Stmts {
+		decompileListedStmt(w, stmt, deep)
+	}
+}
+
+// decompileListedStmt writes a statement on its own line(s), and calls the hooks around it
+func decompileListedStmt(w *printer, stmt ast.Stmt, deep int) {
+	if w.hooks.BeforeStmt != nil {
+		w.WriteString(w.hooks.BeforeStmt(stmt, indent(deep)))
+	}
+	start := w.Len()
+	decompileStmt(w, stmt, deep)
+	if w.hooks.AfterStmt != nil {
+		w.WriteString(w.hooks.AfterStmt(stmt, bytes.ContainsRune(w.Bytes()[start:], '\n')))
+	}
+	w.WriteString("\n")
+}
+
+// decompileBlock writes the statements between braces, the closing brace is indented at the given depth
+func decompileBlock(w *printer, stmt ast.Stmt, deep int) {
+	w.WriteString("{\n")
+	decompileBody(w, stmt, deep)
+	w.WriteString(indent(deep) + "}")
 }
 
-func decompileStmt(w *bytes.Buffer, stmt ast.Stmt, deep int) {
-	prefix := strings.Repeat(" ", 4*deep)
+func decompileStmt(w *printer, stmt ast.Stmt, deep int) {
+	w.WriteString(indent(deep))
 	switch s := stmt.(type) {
-	case *ast.IfStmt:
-		decompileIfStmt(w, prefix, s, deep)
-	case *ast.SwitchStmt:
-		decompileSwitchStmt(w, prefix, s, deep)
-	case *ast.SwitchCaseStmt:
-		decompileSwitchCaseStmt(w, prefix, s, deep)
-	case *ast.ExprStmt: // Completed
+	case *ast.StmtsStmt:
+		w.WriteString("{\n")
+		decompileStmts(w, s, deep+1)
+		w.WriteString(indent(deep) + "}")
+	case *ast.ExprStmt:
 		decompileExpr(w, s.Expr, deep)
-	case *ast.LetsStmt: // Completed
-		decompileLetsStmt(w, prefix, s)
-	case *ast.BreakStmt: // Completed
-		decompileBreakStmt(w, prefix)
-	case *ast.ReturnStmt:
-		decompileReturnStmt(w, s, prefix)
-	case *ast.LoopStmt:
-		decompileLoopStmt(w, prefix, s, deep)
+	case *ast.DbgStmt:
+		decompileDbgStmt(w, s)
+	case *ast.LabelStmt:
+		decompileLabelStmt(w, s, deep)
+	case *ast.IfStmt:
+		decompileIfStmt(w, s, deep)
+	case *ast.TryStmt:
+		decompileTryStmt(w, s, deep)
 	case *ast.ForStmt:
-		decompileForStmt(w, prefix, s, deep)
-	case *ast.CForStmt: // Completed
-		decompileCForStmt(w, prefix, s, deep)
+		decompileForStmt(w, s, deep)
+	case *ast.CForStmt:
+		decompileCForStmt(w, s, deep)
+	case *ast.LoopStmt:
+		decompileLoopStmt(w, s, deep)
+	case *ast.BreakStmt:
+		decompileBreakStmt(w, s)
+	case *ast.ContinueStmt:
+		decompileContinueStmt(w, s)
+	case *ast.ReturnStmt:
+		decompileReturnStmt(w, s, deep)
+	case *ast.ThrowStmt:
+		decompileThrowStmt(w, s, deep)
+	case *ast.YieldStmt:
+		decompileYieldStmt(w, s, deep)
+	case *ast.ModuleStmt:
+		decompileModuleStmt(w, s, deep)
+	case *ast.SelectStmt:
+		decompileSelectStmt(w, s, deep)
+	case *ast.SwitchStmt:
+		decompileSwitchStmt(w, s, deep)
+	case *ast.VarStmt:
+		decompileVarStmt(w, s, deep)
+	case *ast.LetsStmt:
+		decompileLetsStmt(w, s, deep)
+	case *ast.LetMapItemStmt:
+		decompileLetMapItemStmt(w, s, deep)
+	case *ast.GoroutineStmt:
+		decompileGoroutineStmt(w, s, deep)
+	case *ast.DeferStmt:
+		decompileDeferStmt(w, s, deep)
+	case *ast.WithStmt:
+		decompileWithStmt(w, s, deep)
+	case *ast.TypeStmt:
+		decompileTypeStmt(w, s, deep)
+	case *ast.EnumStmt:
+		w.WriteString("enum " + s.Name + " { " + strings.Join(s.Names, ", ") + " }")
+	case *ast.ImportStmt:
+		w.WriteString("import " + quote(s.Path) + " as " + s.Name)
+	case *ast.ExportStmt:
+		decompileExportStmt(w, s, deep)
 	default:
-		panic(fmt.Sprintf("stmt: %s %s", s, reflect.TypeOf(s)))
+		panic(fmt.Sprintf("unsupported statement %T", s))
 	}
 }
 
-func decompileExpr(w *bytes.Buffer, expr ast.Expr, deep int) {
-	prefix := strings.Repeat(" ", 4*deep)
-	switch e := expr.(type) {
+func decompileDbgStmt(w *printer, s *ast.DbgStmt) {
+	w.WriteString("dbg(")
+	if s.TypeData != nil {
+		decompileType(w, s.TypeData)
+	}
+	w.WriteString(")")
+}
+
+func decompileTypeStmt(w *printer, s *ast.TypeStmt, deep int) {
+	w.WriteString("type " + s.Name + " ")
+	if s.TypeData.Kind != ast.TypeStructType || len(s.TypeData.StructNames) == 0 {
+		decompileType(w, s.TypeData)
+		return
+	}
+	w.WriteString("struct {\n")
+	for i, name := range s.TypeData.StructNames {
+		w.WriteString(indent(deep + 1))
+		decompileParam(w, &ast.ParamExpr{Name: name, TypeData: s.TypeData.StructTypes[i]})
+		w.WriteString("\n")
+	}
+	w.WriteString(indent(deep) + "}")
+}
+
+// inClause calls fn to write the clause of an if, for, switch or catch statement.
+// A struct literal is parenthesized there, not to be read as the block of the statement.
+func inClause(w *printer, fn func()) {
+	clause := w.clause
+	w.clause = true
+	fn()
+	w.clause = clause
+}
+
+// inPattern calls fn to write a destructuring pattern, or the targets of an assignment.
+func inPattern(w *printer, fn func()) {
+	pattern := w.pattern
+	w.pattern = true
+	fn()
+	w.pattern = pattern
+}
+
+func decompileLabelStmt(w *printer, s *ast.LabelStmt, deep int) {
+	w.WriteString(s.Name + ":\n")
+	decompileStmt(w, s.Stmt, deep)
+}
+
+func decompileIfStmt(w *printer, s *ast.IfStmt, deep int) {
+	w.WriteString("if ")
+	inClause(w, func() { decompileExpr(w, s.If, deep) })
+	w.WriteString(" ")
+	decompileBlock(w, s.Then, deep)
+	switch e := s.Else.(type) {
 	case nil:
-	case *ast.ParenExpr: // Completed
-		decompileParenExpr(w, prefix, e)
-	case *ast.LenExpr: // Completed
-		decompileLenExpr(w, prefix, e)
-	case *ast.ItemExpr:
-		decompileItemExpr(w, prefix, e)
-	case *ast.UnaryExpr:
-		decompileUnaryExpr(w, prefix, e)
-	case *ast.MemberExpr:
-		decompileMemberExpr(w, prefix, e)
-	case *ast.AnonCallExpr:
-		decompileAnonCallExpr(w, prefix, e)
-	case *ast.FuncExpr:
-		decompileFuncExpr(w, prefix, e, deep)
-	case *ast.ConstExpr:
-		decompileConstExpr(w, prefix, e)
-	case *ast.MapExpr:
-		decompileMapExpr(w, prefix, e)
-	case *ast.ArrayExpr:
-		decompileArrayExpr(w, prefix, e)
-	case *ast.StringExpr: // Completed
-		decompileStringExpr(w, prefix, e)
-	case *ast.CallExpr: // Completed
-		decompileCallExpr(w, prefix, e)
-	case *ast.AssocExpr: // Completed
-		decompileAssocExpr(w, prefix, e)
-	case *ast.BinOpExpr: // Completed
-		decompileBinOpExpr(w, prefix, e)
-	case *ast.NumberExpr: // Completed
-		decompileNumberExpr(w, prefix, e)
-	case *ast.IdentExpr: // Completed
-		decompileIdentExpr(w, prefix, e)
+	case *ast.IfStmt:
+		w.WriteString(" else ")
+		decompileIfStmt(w, e, deep)
 	default:
-		panic(fmt.Sprintf("expr: %s %s", e, reflect.TypeOf(e)))
+		w.WriteString(" else ")
+		decompileBlock(w, e, deep)
 	}
 }
 
-func decompileCForStmt(w *bytes.Buffer, prefix string, s *ast.CForStmt, deep int) {
-	w.WriteString(prefix + "for ")
-	if el, ok := s.Stmt1.(*ast.LetsStmt); ok {
-		decompileLetsStmtHelper(w, el)
+func decompileTryStmt(w *printer, s *ast.TryStmt, deep int) {
+	w.WriteString("try ")
+	decompileBlock(w, s.Try, deep)
+	for _, c := range s.Catches {
+		w.WriteString(" catch ")
+		if c.Var != "" {
+			w.WriteString(c.Var + " ")
+		}
+		w.WriteString("in ")
+		inClause(w, func() { joinExpr(w, c.Kinds, deep) })
+		w.WriteString(" ")
+		decompileBlock(w, c.Stmt, deep)
+	}
+	if s.Catch != nil || len(s.Catches) == 0 {
+		w.WriteString(" catch ")
+		if s.Var != "" {
+			w.WriteString(s.Var + " ")
+		}
+		decompileBlock(w, s.Catch, deep)
+	}
+	if s.Finally != nil {
+		w.WriteString(" finally ")
+		decompileBlock(w, s.Finally, deep)
 	}
-	w.WriteString("; ")
-	decompileExpr(w, s.Expr2, 0)
-	w.WriteString("; ")
-	decompileExpr(w, s.Expr3, 0)
-	w.WriteString(" {\n")
-	decompileStmt(w, s.Stmt, deep+1)
-	w.WriteString(prefix + "}\n")
 }
 
-func joinStr(w *bytes.Buffer, arr []string) {
-	for i := 0; i < len(arr); i++ {
-		w.WriteString(arr[i])
-		if i < len(arr)-1 {
-			w.WriteString(", ")
-		}
+func decompileForStmt(w *printer, s *ast.ForStmt, deep int) {
+	w.WriteString("for ")
+	if s.Pattern != nil {
+		inPattern(w, func() { decompileExpr(w, s.Pattern, deep) })
+	} else {
+		w.WriteString(strings.Join(s.Vars, ", "))
 	}
+	w.WriteString(" in ")
+	inClause(w, func() { decompileOperand(w, s.Value, deep) })
+	w.WriteString(" ")
+	decompileBlock(w, s.Stmt, deep)
 }
-func joinExpr(w *bytes.Buffer, arr []ast.Expr) {
-	for i := 0; i < len(arr); i++ {
-		decompileExpr(w, arr[i], 0)
-		if i < len(arr)-1 {
-			w.WriteString(", ")
+
+func decompileCForStmt(w *printer, s *ast.CForStmt, deep int) {
+	w.WriteString("for ")
+	inClause(w, func() {
+		if s.Stmt1 != nil {
+			decompileStmt(w, s.Stmt1, 0)
 		}
+		w.WriteString("; ")
+		decompileExpr(w, s.Expr2, deep)
+		w.WriteString("; ")
+		decompileExpr(w, s.Expr3, deep)
+	})
+	w.WriteString(" ")
+	decompileBlock(w, s.Stmt, deep)
+}
+
+func decompileLoopStmt(w *printer, s *ast.LoopStmt, deep int) {
+	w.WriteString("for ")
+	if s.Expr != nil {
+		inClause(w, func() { decompileExpr(w, s.Expr, deep) })
+		w.WriteString(" ")
 	}
+	decompileBlock(w, s.Stmt, deep)
 }
 
-func decompileForStmt(w *bytes.Buffer, prefix string, s *ast.ForStmt, deep int) {
-	w.WriteString(prefix + "for ")
-	joinStr(w, s.Vars)
-	if len(s.Vars) > 0 {
-		w.WriteString(" in ")
+func decompileBreakStmt(w *printer, s *ast.BreakStmt) {
+	w.WriteString("break")
+	if s.Label != "" {
+		w.WriteString(" " + s.Label)
 	}
-	decompileExpr(w, s.Value, 0)
-	w.WriteString(" {\n")
-	decompileStmt(w, s.Stmt, deep+1)
-	w.WriteString(prefix + "}\n")
 }
 
-func decompileLoopStmt(w *bytes.Buffer, prefix string, s *ast.LoopStmt, deep int) {
-	w.WriteString(prefix + "for ")
-	decompileExpr(w, s.Expr, 0)
-	w.WriteString(" {\n")
-	decompileStmt(w, s.Stmt, deep+1)
-	w.WriteString(prefix + "}\n")
+func decompileContinueStmt(w *printer, s *ast.ContinueStmt) {
+	w.WriteString("continue")
+	if s.Label != "" {
+		w.WriteString(" " + s.Label)
+	}
 }
 
-func decompileReturnStmt(w *bytes.Buffer, s *ast.ReturnStmt, prefix string) {
-	for _, e := range s.Exprs.(*ast.ExprsExpr).Exprs {
-		w.WriteString(prefix + "return ")
-		decompileExpr(w, e, 0)
-		w.WriteString("\n")
+func decompileReturnStmt(w *printer, s *ast.ReturnStmt, deep int) {
+	w.WriteString("return")
+	if exprs, ok := s.Exprs.(*ast.ExprsExpr); ok && len(exprs.Exprs) > 0 {
+		w.WriteString(" ")
+		joinExpr(w, exprs.Exprs, deep)
 	}
 }
 
-func decompileBreakStmt(w *bytes.Buffer, prefix string) {
-	_, _ = w.WriteString(prefix + "break\n")
+func decompileThrowStmt(w *printer, s *ast.ThrowStmt, deep int) {
+	w.WriteString("throw ")
+	decompileExpr(w, s.Expr, deep)
 }
 
-func decompileLetsStmtHelper(w *bytes.Buffer, s *ast.LetsStmt) {
-	joinExpr(w, s.Lhss.(*ast.ExprsExpr).Exprs)
-	w.WriteString(" = ")
-	joinExpr(w, s.Rhss.(*ast.ExprsExpr).Exprs)
+func decompileYieldStmt(w *printer, s *ast.YieldStmt, deep int) {
+	w.WriteString("yield ")
+	decompileExpr(w, s.Expr, deep)
 }
 
-func decompileLetsStmt(w *bytes.Buffer, prefix string, s *ast.LetsStmt) {
-	w.WriteString(prefix)
-	decompileLetsStmtHelper(w, s)
-	w.WriteString("\n")
+func decompileModuleStmt(w *printer, s *ast.ModuleStmt, deep int) {
+	w.WriteString("module " + s.Name + " ")
+	decompileBlock(w, s.Stmt, deep)
+}
+
+func decompileExportStmt(w *printer, s *ast.ExportStmt, deep int) {
+	w.WriteString("export ")
+	switch stmt := s.Stmt.(type) {
+	case *ast.VarStmt:
+		decompileVarStmt(w, stmt, deep)
+	case *ast.LetsStmt:
+		decompileLetsStmt(w, stmt, deep)
+	case *ast.LetMapItemStmt:
+		decompileLetMapItemStmt(w, stmt, deep)
+	case *ast.ExprStmt:
+		decompileExpr(w, stmt.Expr, deep)
+	default:
+		panic(fmt.Sprintf("unsupported exported statement %T", s.Stmt))
+	}
 }
 
-func decompileSwitchCaseStmt(w *bytes.Buffer, prefix string, s *ast.SwitchCaseStmt, deep int) {
-	w.WriteString(prefix + "case ")
-	joinExpr(w, s.Exprs.Exprs)
-	w.WriteString(":\n")
-	decompileStmt(w, s.Stmt, deep+1)
+func decompileSelectStmt(w *printer, s *ast.SelectStmt, deep int) {
+	w.WriteString("select {\n")
+	body, ok := s.Body.(*ast.SelectBodyStmt)
+	if !ok {
+		panic(fmt.Sprintf("unsupported select body %T", s.Body))
+	}
+	for _, c := range body.Cases {
+		selectCase, ok := c.(*ast.SelectCaseStmt)
+		if !ok {
+			panic(fmt.Sprintf("unsupported select case %T", c))
+		}
+		decompileClause(w, selectCase, deep, func() {
+			w.WriteString("case ")
+			decompileStmt(w, selectCase.Expr, 0)
+			w.WriteString(":")
+		})
+		decompileBody(w, selectCase.Stmt, deep)
+	}
+	if body.Default != nil {
+		w.WriteString(indent(deep) + "default:\n")
+		decompileBody(w, body.Default, deep)
+	}
+	w.WriteString(indent(deep) + "}")
 }
 
-func decompileSwitchStmt(w *bytes.Buffer, prefix string, s *ast.SwitchStmt, deep int) {
-	w.WriteString(prefix + "switch ")
-	decompileExpr(w, s.Expr, 0)
+func decompileSwitchStmt(w *printer, s *ast.SwitchStmt, deep int) {
+	w.WriteString("switch ")
+	inClause(w, func() { decompileExpr(w, s.Expr, deep) })
 	w.WriteString(" {\n")
 	for _, c := range s.Cases {
-		decompileStmt(w, c, deep)
-		w.WriteString("\n")
+		switchCase, ok := c.(*ast.SwitchCaseStmt)
+		if !ok {
+			panic(fmt.Sprintf("unsupported switch case %T", c))
+		}
+		decompileClause(w, switchCase, deep, func() {
+			w.WriteString("case ")
+			joinExpr(w, switchCase.Exprs.Exprs, deep)
+			w.WriteString(":")
+		})
+		decompileBody(w, switchCase.Stmt, deep)
+	}
+	if s.Default != nil {
+		w.WriteString(indent(deep) + "default:\n")
+		decompileBody(w, s.Default, deep)
 	}
-	w.WriteString(prefix + "default:\n")
-	decompileStmt(w, s.Default, deep+1)
-	w.WriteString(prefix + "}\n")
+	w.WriteString(indent(deep) + "}")
 }
 
-func decompileIfStmt(w *bytes.Buffer, prefix string, s *ast.IfStmt, deep int) {
-	w.WriteString(prefix + "if ")
-	decompileExpr(w, s.If, 0)
-	w.WriteString(" {\n")
-	decompileStmt(w, s.Then, deep+1)
-	if s.Else != nil {
-		w.WriteString(prefix + "} else {\n")
-		decompileStmt(w, s.Else, deep+1)
+// decompileClause writes the first line of a case clause with the given function, and calls the hooks around it
+func decompileClause(w *printer, clause ast.Stmt, deep int, writeHead func()) {
+	if w.hooks.BeforeStmt != nil {
+		w.WriteString(w.hooks.BeforeStmt(clause, indent(deep)))
+	}
+	w.WriteString(indent(deep))
+	writeHead()
+	if w.hooks.AfterStmt != nil {
+		w.WriteString(w.hooks.AfterStmt(clause, false))
+	}
+	w.WriteString("\n")
+}
+
+// decompileBody writes the statements one level deeper than the given depth, one per line
+func decompileBody(w *printer, stmt ast.Stmt, deep int) {
+	switch s := stmt.(type) {
+	case nil:
+	case *ast.StmtsStmt:
+		decompileStmts(w, s, deep+1)
+	default:
+		decompileListedStmt(w, s, deep+1)
+	}
+	if w.hooks.BlockEnd != nil {
+		w.WriteString(w.hooks.BlockEnd(stmt, indent(deep+1)))
+	}
+}
+
+func decompileVarStmt(w *printer, s *ast.VarStmt, deep int) {
+	w.WriteString("var ")
+	if s.Pattern != nil {
+		inPattern(w, func() { decompileExpr(w, s.Pattern, deep) })
+	} else {
+		w.WriteString(strings.Join(s.Names, ", "))
+	}
+	w.WriteString(" = ")
+	joinExpr(w, s.Exprs, deep)
+}
+
+func decompileLetsStmt(w *printer, s *ast.LetsStmt, deep int) {
+	if s.Mutable {
+		w.WriteString("mut ")
+	}
+	inPattern(w, func() { decompileExprsExpr(w, s.Lhss, deep) })
+	if s.Typed {
+		w.WriteString(" := ")
+	} else {
+		w.WriteString(" = ")
+	}
+	decompileExprsExpr(w, s.Rhss, deep)
+}
+
+func decompileLetMapItemStmt(w *printer, s *ast.LetMapItemStmt, deep int) {
+	decompileExprsExpr(w, s.Lhss, deep)
+	w.WriteString(" = ")
+	decompileExpr(w, s.Rhs, deep)
+}
+
+func decompileGoroutineStmt(w *printer, s *ast.GoroutineStmt, deep int) {
+	w.WriteString("go ")
+	decompileExpr(w, s.Expr, deep)
+}
+
+func decompileDeferStmt(w *printer, s *ast.DeferStmt, deep int) {
+	w.WriteString("defer ")
+	decompileExpr(w, s.Expr, deep)
+}
+
+func decompileWithStmt(w *printer, s *ast.WithStmt, deep int) {
+	if s.Kind == ast.WithCancel {
+		w.WriteString("with cancel(")
+	} else {
+		w.WriteString("with timeout(")
+	}
+	decompileExpr(w, s.Expr, deep)
+	w.WriteString(") ")
+	decompileBlock(w, s.Stmt, deep)
+}
+
+func decompileExpr(w *printer, expr ast.Expr, deep int) {
+	switch e := expr.(type) {
+	case nil:
+	case *ast.NumberExpr:
+		w.WriteString(e.Lit)
+	case *ast.DurationExpr:
+		w.WriteString(e.Lit)
+	case *ast.StringExpr:
+		w.WriteString(quote(e.Lit))
+	case *ast.InterpExpr:
+		decompileInterpExpr(w, e, deep)
+	case *ast.ConstExpr:
+		w.WriteString(e.Value)
+	case *ast.IdentExpr:
+		w.WriteString(e.Lit)
+	case *ast.ExprsExpr:
+		joinExpr(w, e.Exprs, deep)
+	case *ast.ArrayExpr:
+		decompileArrayExpr(w, e, deep)
+	case *ast.MapExpr:
+		decompileMapExpr(w, e, deep)
+	case *ast.StructExpr:
+		decompileStructExpr(w, e, deep)
+	case *ast.UnaryExpr:
+		w.WriteString(e.Operator)
+		decompileOperand(w, e.Expr, deep)
+	case *ast.AddrExpr:
+		w.WriteString("&")
+		decompileOperand(w, e.Expr, deep)
+	case *ast.DerefExpr:
+		w.WriteString("*")
+		decompileOperand(w, e.Expr, deep)
+	case *ast.ParenExpr:
+		w.WriteString("(")
+		clause := w.clause
+		w.clause = false // already parenthesized
+		decompileExpr(w, e.SubExpr, deep)
+		w.clause = clause
+		w.WriteString(")")
+	case *ast.BinOpExpr:
+		decompileBinary(w, e.Lhs, e.Operator, e.Rhs, deep)
+	case *ast.NilCoalescingOpExpr:
+		decompileBinary(w, e.Lhs, "??", e.Rhs, deep)
+	case *ast.TernaryOpExpr:
+		decompileTernaryOpExpr(w, e, deep)
+	case *ast.CallExpr:
+		w.WriteString(e.Name)
+		decompileCallArgs(w, e.Callable, deep)
+	case *ast.AnonCallExpr:
+		decompileOperand(w, e.Expr, deep)
+		decompileCallArgs(w, e.Callable, deep)
+	case *ast.MemberExpr:
+		decompileOperand(w, e.Expr, deep)
+		if e.Optional {
+			w.WriteString("?")
+		}
+		w.WriteString("." + e.Name)
+	case *ast.ItemExpr:
+		decompileOperand(w, e.Value, deep)
+		if e.Optional {
+			w.WriteString("?")
+		}
+		w.WriteString("[")
+		decompileExpr(w, e.Index, deep)
+		w.WriteString("]")
+	case *ast.SliceExpr:
+		decompileSliceExpr(w, e, deep)
+	case *ast.FuncExpr:
+		decompileFuncExpr(w, e, deep)
+	case *ast.LetsExpr:
+		joinExpr(w, e.Lhss, deep)
+		w.WriteString(" " + e.Operator + " ")
+		joinExpr(w, e.Rhss, deep)
+	case *ast.AssocExpr:
+		decompileAssocExpr(w, e, deep)
+	case *ast.ChanExpr:
+		decompileChanExpr(w, e, deep)
+	case *ast.MakeExpr:
+		decompileMakeExpr(w, e, deep)
+	case *ast.MakeTypeExpr:
+		w.WriteString("make(type " + e.Name + ", ")
+		decompileExpr(w, e.Type, deep)
+		w.WriteString(")")
+	case *ast.LenExpr:
+		decompileBuiltin(w, "len", deep, e.Expr)
+	case *ast.CloseExpr:
+		decompileBuiltin(w, "close", deep, e.WhatExpr)
+	case *ast.DeleteExpr:
+		if e.KeyExpr != nil {
+			decompileBuiltin(w, "delete", deep, e.WhatExpr, e.KeyExpr)
+		} else {
+			decompileBuiltin(w, "delete", deep, e.WhatExpr)
+		}
+	case *ast.IncludeExpr:
+		decompileIncludeExpr(w, e, deep)
+	case *ast.SpreadExpr:
+		w.WriteString("...")
+		decompileOperand(w, e.Expr, deep)
+	case *ast.ComprehensionExpr:
+		decompileComprehensionExpr(w, e, deep)
+	case *ast.AsyncExpr:
+		w.WriteString("async ")
+		decompileOperand(w, e.Expr, deep)
+	case *ast.AwaitExpr:
+		decompileAwaitExpr(w, e, deep)
+	default:
+		panic(fmt.Sprintf("unsupported expression %T", e))
+	}
+}
+
+func decompileComprehensionExpr(w *printer, e *ast.ComprehensionExpr, deep int) {
+	if e.Key != nil {
+		w.WriteString("{")
+		decompileExpr(w, e.Key, deep)
+		w.WriteString(": ")
+	} else {
+		w.WriteString("[")
+	}
+	decompileExpr(w, e.Expr, deep)
+	w.WriteString(" for ")
+	if e.Pattern != nil {
+		inPattern(w, func() { decompileExpr(w, e.Pattern, deep) })
+	} else {
+		w.WriteString(strings.Join(e.Vars, ", "))
+	}
+	w.WriteString(" in ")
+	decompileOperand(w, e.Value, deep)
+	if e.Cond != nil {
+		w.WriteString(" if ")
+		decompileExpr(w, e.Cond, deep)
+	}
+	if e.Key != nil {
+		w.WriteString("}")
+	} else {
+		w.WriteString("]")
+	}
+}
+
+func decompileAwaitExpr(w *printer, e *ast.AwaitExpr, deep int) {
+	w.WriteString("await ")
+	switch e.Kind {
+	case ast.AwaitTask:
+		decompileOperand(w, e.Exprs[0], deep)
+	case ast.AwaitAll:
+		decompileBuiltin(w, "all", deep, e.Exprs...)
+	case ast.AwaitRace:
+		decompileBuiltin(w, "race", deep, e.Exprs...)
+	}
+	if e.Timeout != nil {
+		w.WriteString(" timeout ")
+		decompileOperand(w, e.Timeout, deep)
 	}
-	w.WriteString(prefix + "}\n")
 }
 
-func decompileParenExpr(w *bytes.Buffer, prefix string, e *ast.ParenExpr) {
-	w.WriteString(prefix)
+// decompileOperand writes an expression used as the operand of an operator, a call, a member or an item.
+// Anything but a primary expression is wrapped in parentheses.
+func decompileOperand(w *printer, expr ast.Expr, deep int) {
+	if isPrimary(expr) {
+		decompileExpr(w, expr, deep)
+		return
+	}
 	w.WriteString("(")
-	decompileExpr(w, e.SubExpr, 0)
+	decompileExpr(w, expr, deep)
 	w.WriteString(")")
 }
 
-func decompileLenExpr(w *bytes.Buffer, prefix string, e *ast.LenExpr) {
-	w.WriteString(prefix)
-	w.WriteString("len(")
-	decompileExpr(w, e.Expr, 0)
-	w.WriteString(")")
+func isPrimary(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.NumberExpr:
+		return !strings.HasPrefix(e.Lit, "-")
+	case *ast.StringExpr, *ast.DurationExpr, *ast.InterpExpr, *ast.ConstExpr, *ast.IdentExpr, *ast.ArrayExpr, *ast.MapExpr, *ast.StructExpr, *ast.ParenExpr,
+		*ast.ComprehensionExpr,
+		*ast.CallExpr, *ast.AnonCallExpr, *ast.MemberExpr, *ast.ItemExpr, *ast.SliceExpr,
+		*ast.MakeExpr, *ast.MakeTypeExpr, *ast.LenExpr, *ast.CloseExpr, *ast.DeleteExpr:
+		return true
+	}
+	return false
 }
 
-func decompileItemExpr(w *bytes.Buffer, prefix string, e *ast.ItemExpr) {
-	w.WriteString(prefix)
-	decompileExpr(w, e.Value, 0)
-	w.WriteString("[")
-	decompileExpr(w, e.Index, 0)
-	w.WriteString("]")
+func joinExpr(w *printer, arr []ast.Expr, deep int) {
+	for i, e := range arr {
+		if i > 0 {
+			w.WriteString(", ")
+		}
+		decompileExpr(w, e, deep)
+	}
+}
+
+func decompileExprsExpr(w *printer, expr ast.Expr, deep int) {
+	if exprs, ok := expr.(*ast.ExprsExpr); ok {
+		joinExpr(w, exprs.Exprs, deep)
+		return
+	}
+	decompileExpr(w, expr, deep)
+}
+
+func decompileBuiltin(w *printer, name string, deep int, args ...ast.Expr) {
+	w.WriteString(name + "(")
+	joinExpr(w, args, deep)
+	w.WriteString(")")
 }
 
-func decompileUnaryExpr(w *bytes.Buffer, prefix string, e *ast.UnaryExpr) {
-	w.WriteString(prefix)
-	w.WriteString(e.Operator)
-	decompileExpr(w, e.Expr, 0)
+func decompileBinary(w *printer, lhs ast.Expr, operator string, rhs ast.Expr, deep int) {
+	decompileOperand(w, lhs, deep)
+	w.WriteString(" " + operator + " ")
+	decompileOperand(w, rhs, deep)
 }
 
-func decompileMemberExpr(w *bytes.Buffer, prefix string, e *ast.MemberExpr) {
-	w.WriteString(prefix)
-	decompileExpr(w, e.Expr, 0)
-	w.WriteString(".")
-	w.WriteString(e.Name)
+func decompileTernaryOpExpr(w *printer, e *ast.TernaryOpExpr, deep int) {
+	decompileOperand(w, e.Expr, deep)
+	w.WriteString(" ? ")
+	decompileOperand(w, e.Lhs, deep)
+	w.WriteString(" : ")
+	decompileOperand(w, e.Rhs, deep)
 }
 
-func decompileAnonCallExpr(w *bytes.Buffer, prefix string, e *ast.AnonCallExpr) {
-	w.WriteString(prefix)
-	decompileExpr(w, e.Expr, 0)
+func decompileCallArgs(w *printer, c *ast.Callable, deep int) {
 	w.WriteString("(")
-	joinExpr(w, e.SubExprs.Exprs)
+	if c.SubExprs != nil {
+		joinExpr(w, c.SubExprs.Exprs, deep)
+	}
+	if c.VarArg {
+		w.WriteString("...")
+	}
 	w.WriteString(")")
 }
 
-func decompileFuncExpr(w *bytes.Buffer, prefix string, e *ast.FuncExpr, deep int) {
-	w.WriteString(prefix)
-	w.WriteString("func " + e.Name + "(")
-	//joinStr(w, e.Params)
-	w.WriteString(") {\n")
-	decompileStmt(w, e.Stmt, deep+1)
-	w.WriteString("}\n")
+func decompileSliceExpr(w *printer, e *ast.SliceExpr, deep int) {
+	decompileOperand(w, e.Value, deep)
+	w.WriteString("[")
+	decompileExpr(w, e.Begin, deep)
+	w.WriteString(":")
+	decompileExpr(w, e.End, deep)
+	w.WriteString("]")
 }
 
-func decompileConstExpr(w *bytes.Buffer, prefix string, e *ast.ConstExpr) {
-	w.WriteString(prefix)
-	w.WriteString(e.Value)
+func decompileIncludeExpr(w *printer, e *ast.IncludeExpr, deep int) {
+	decompileOperand(w, e.ItemExpr, deep)
+	w.WriteString(" in ")
+	if list, ok := e.ListExpr.(*ast.SliceExpr); ok && list.Begin == nil && list.End == nil {
+		decompileOperand(w, list.Value, deep)
+	} else {
+		decompileOperand(w, e.ListExpr, deep)
+	}
 }
 
-func decompileMapExpr(w *bytes.Buffer, prefix string, e *ast.MapExpr) {
-	w.WriteString(prefix)
-	w.WriteString(`{`)
-	for i, k := range e.Keys.Exprs {
-		v := e.Values.Exprs[i]
-		decompileExpr(w, k, 0)
-		w.WriteString(": ")
-		decompileExpr(w, v, 0)
-		w.WriteString("\n")
+func decompileAssocExpr(w *printer, e *ast.AssocExpr, deep int) {
+	decompileOperand(w, e.Lhs, deep)
+	if e.Operator == "++" || e.Operator == "--" {
+		w.WriteString(e.Operator)
+		return
+	}
+	w.WriteString(" " + e.Operator + " ")
+	decompileOperand(w, e.Rhs, deep)
+}
+
+func decompileChanExpr(w *printer, e *ast.ChanExpr, deep int) {
+	if e.Lhs != nil {
+		decompileOperand(w, e.Lhs, deep)
+		w.WriteString(" ")
 	}
-	w.WriteString(`}`)
+	w.WriteString("<- ")
+	decompileOperand(w, e.Rhs, deep)
 }
 
-func decompileArrayExpr(w *bytes.Buffer, prefix string, e *ast.ArrayExpr) {
-	w.WriteString(prefix)
+func decompileArrayExpr(w *printer, e *ast.ArrayExpr, deep int) {
+	if e.TypeData != nil {
+		decompileType(w, e.TypeData)
+		w.WriteString("{")
+		joinExpr(w, e.Exprs.Exprs, deep)
+		w.WriteString("}")
+		return
+	}
 	w.WriteString("[")
-	joinExpr(w, e.Exprs.Exprs)
+	joinExpr(w, e.Exprs.Exprs, deep)
 	w.WriteString("]")
 }
 
-func decompileStringExpr(w *bytes.Buffer, prefix string, e *ast.StringExpr) {
-	w.WriteString(prefix)
-	w.WriteString(`"` + e.Lit + `"`)
+func decompileMapExpr(w *printer, e *ast.MapExpr, deep int) {
+	if e.TypeData != nil {
+		decompileType(w, e.TypeData)
+	}
+	if w.hooks.elements && hasElementComments(e) {
+		decompileCommentedMapExpr(w, e, deep)
+		return
+	}
+	w.WriteString("{")
+	for i := range e.Keys.Exprs {
+		if i > 0 {
+			w.WriteString(", ")
+		}
+		decompileMapElement(w, e, i, deep)
+	}
+	w.WriteString("}")
+}
+
+// decompileCommentedMapExpr writes the map literal one element per line, with the comments of its elements
+func decompileCommentedMapExpr(w *printer, e *ast.MapExpr, deep int) {
+	c := &commentWriter{}
+	w.WriteString("{\n")
+	for i, k := range e.Keys.Exprs {
+		leading, trailing := elementComments(k, e.Values.Exprs[i])
+		for _, comment := range leading {
+			w.WriteString(indent(deep+1) + comment.Text + "\n")
+		}
+		w.WriteString(indent(deep + 1))
+		decompileMapElement(w, e, i, deep+1)
+		w.WriteString(",")
+		var sb strings.Builder
+		c.writeTrailing(&sb, trailing, indent(deep+1), true)
+		w.WriteString(sb.String() + "\n")
+	}
+	w.WriteString(indent(deep) + "}")
+}
+
+func decompileMapElement(w *printer, e *ast.MapExpr, i, deep int) {
+	k := e.Keys.Exprs[i]
+	if _, ok := k.(*ast.SpreadExpr); ok {
+		decompileExpr(w, k, deep)
+		return
+	}
+	if w.pattern && isShorthandKey(k, e.Values.Exprs[i]) {
+		decompileExpr(w, e.Values.Exprs[i], deep)
+		return
+	}
+	decompileExpr(w, k, deep)
+	w.WriteString(": ")
+	decompileExpr(w, e.Values.Exprs[i], deep)
+}
+
+// isShorthandKey returns true if the key of a map pattern is the name of its value, written {name} for {"name": name}.
+func isShorthandKey(key, value ast.Expr) bool {
+	k, ok1 := key.(*ast.StringExpr)
+	v, ok2 := value.(*ast.IdentExpr)
+	return ok1 && ok2 && k.Lit == v.Lit
 }
 
-func decompileCallExpr(w *bytes.Buffer, prefix string, e *ast.CallExpr) {
-	w.WriteString(prefix)
-	w.WriteString(e.Name + "(")
-	joinExpr(w, e.SubExprs.Exprs)
+func decompileStructExpr(w *printer, e *ast.StructExpr, deep int) {
+	if w.clause {
+		w.WriteString("(")
+		defer w.WriteString(")")
+	}
+	decompileTypeName(w, e.TypeData)
+	w.WriteString("{")
+	for i, value := range e.Values.Exprs {
+		if i > 0 {
+			w.WriteString(", ")
+		}
+		if len(e.Names) > 0 {
+			w.WriteString(e.Names[i] + ": ")
+		}
+		decompileExpr(w, value, deep)
+	}
+	w.WriteString("}")
+}
+
+func decompileFuncExpr(w *printer, e *ast.FuncExpr, deep int) {
+	w.WriteString("func")
+	if e.Receiver != nil {
+		w.WriteString(" (")
+		decompileParam(w, e.Receiver)
+		w.WriteString(")")
+	}
+	if e.Name != "" {
+		w.WriteString(" " + e.Name)
+	}
+	w.WriteString("(")
+	for i, param := range e.Params {
+		if i > 0 {
+			w.WriteString(", ")
+		}
+		if e.VarArg && i == len(e.Params)-1 {
+			decompileParamName(w, param)
+			w.WriteString("...")
+			if param.TypeData != nil {
+				w.WriteString(" ")
+				decompileType(w, param.TypeData)
+			}
+			continue
+		}
+		decompileParam(w, param)
+	}
+	w.WriteString(") ")
+	if e.Returns != nil {
+		if len(e.Returns) == 1 {
+			decompileType(w, e.Returns[0].TypeData)
+		} else {
+			w.WriteString("(")
+			for i, ret := range e.Returns {
+				if i > 0 {
+					w.WriteString(", ")
+				}
+				decompileType(w, ret.TypeData)
+			}
+			w.WriteString(")")
+		}
+		w.WriteString(" ")
+	}
+	decompileBlock(w, e.Stmt, deep)
+}
+
+func decompileParam(w *printer, param *ast.ParamExpr) {
+	if param.TypeData == nil {
+		decompileParamName(w, param)
+		return
+	}
+	if param.TypeData.Mutable {
+		w.WriteString("mut ")
+	}
+	w.WriteString(param.Name + " ")
+	decompileType(w, param.TypeData)
+}
+
+func decompileParamName(w *printer, param *ast.ParamExpr) {
+	if param.Pattern != nil {
+		inPattern(w, func() { decompileExpr(w, param.Pattern, 0) })
+		return
+	}
+	w.WriteString(param.Name)
+}
+
+func decompileMakeExpr(w *printer, e *ast.MakeExpr, deep int) {
+	w.WriteString("make(")
+	decompileType(w, e.TypeData)
+	if e.LenExpr != nil {
+		w.WriteString(", ")
+		decompileExpr(w, e.LenExpr, deep)
+	}
+	if e.CapExpr != nil {
+		w.WriteString(", ")
+		decompileExpr(w, e.CapExpr, deep)
+	}
 	w.WriteString(")")
 }
 
-func decompileAssocExpr(w *bytes.Buffer, prefix string, e *ast.AssocExpr) {
-	w.WriteString(prefix)
-	decompileExpr(w, e.Lhs, 0)
-	if e.Operator == "++" || e.Operator == "--" {
-		w.WriteString(e.Operator)
-	} else {
-		w.WriteString(" " + e.Operator + " ")
+// decompileType writes the type the way the parser reads it
+func decompileType(w *printer, t *ast.TypeStruct) {
+	// A named type carries its kind, while other types wrap their sub-type
+	writeSubType := func() {
+		if t.SubType != nil {
+			decompileType(w, t.SubType)
+		} else {
+			decompileTypeName(w, t)
+		}
+	}
+	switch t.Kind {
+	case ast.TypeDefault:
+		decompileTypeName(w, t)
+	case ast.TypePtr:
+		w.WriteString("*")
+		writeSubType()
+	case ast.TypeChan:
+		w.WriteString("chan ")
+		writeSubType()
+	case ast.TypeSlice:
+		w.WriteString(strings.Repeat("[]", max(t.Dimensions, 1)))
+		writeSubType()
+	case ast.TypeMap:
+		w.WriteString("map[")
+		decompileType(w, t.Key)
+		w.WriteString("]")
+		decompileType(w, t.SubType)
+	case ast.TypeStructType:
+		w.WriteString("struct{")
+		for i, name := range t.StructNames {
+			if i > 0 {
+				w.WriteString(", ")
+			}
+			decompileParam(w, &ast.ParamExpr{Name: name, TypeData: t.StructTypes[i]})
+		}
+		w.WriteString("}")
+	default:
+		panic(fmt.Sprintf("unsupported type kind %d", t.Kind))
 	}
-	decompileExpr(w, e.Rhs, 0)
 }
 
-func decompileBinOpExpr(w *bytes.Buffer, prefix string, e *ast.BinOpExpr) {
-	w.WriteString(prefix)
-	decompileExpr(w, e.Lhs, 0)
-	w.WriteString(" " + e.Operator + " ")
-	decompileExpr(w, e.Rhs, 0)
+func decompileTypeName(w *printer, t *ast.TypeStruct) {
+	for _, env := range t.Env {
+		w.WriteString(env + ".")
+	}
+	w.WriteString(t.Name)
 }
 
-func decompileNumberExpr(w *bytes.Buffer, prefix string, e *ast.NumberExpr) {
-	w.WriteString(prefix)
-	w.WriteString(e.Lit)
+func decompileInterpExpr(w *printer, e *ast.InterpExpr, deep int) {
+	w.WriteByte('"')
+	clause := w.clause
+	w.clause = false // the interpolations are scanned on their own
+	for i, expr := range e.Exprs {
+		w.WriteString(escape(e.Strs[i]))
+		w.WriteString("${")
+		decompileExpr(w, expr, deep)
+		w.WriteString("}")
+	}
+	w.clause = clause
+	w.WriteString(escape(e.Strs[len(e.Exprs)]))
+	w.WriteByte('"')
 }
 
-func decompileIdentExpr(w *bytes.Buffer, prefix string, e *ast.IdentExpr) {
-	w.WriteString(prefix)
-	w.WriteString(e.Lit)
+// quote returns the string literal, escaping the characters the lexer unescapes
+func quote(s string) string {
+	return `"` + escape(s) + `"`
+}
+
+// escape escapes the characters the lexer unescapes in a string literal, and the start of the interpolations
+func escape(s string) string {
+	var sb strings.Builder
+	runes := []rune(s)
+	for i, r := range runes {
+		switch r {
+		case '$':
+			if i+1 < len(runes) && runes[i+1] == '{' {
+				sb.WriteString(`\$`)
+			} else {
+				sb.WriteRune(r)
+			}
+		case '"':
+			sb.WriteString(`\"`)
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\b':
+			sb.WriteString(`\b`)
+		case '\f':
+			sb.WriteString(`\f`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\t':
+			sb.WriteString(`\t`)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
 }
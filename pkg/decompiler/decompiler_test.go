@@ -0,0 +1,181 @@
+package decompiler
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+	"github.com/alaingilbert/anko/pkg/ast/optimize"
+	"github.com/alaingilbert/anko/pkg/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	posImplType  = reflect.TypeOf(ast.PosImpl{})
+	commentsType = reflect.TypeOf(ast.CommentsImpl{})
+	parenType    = reflect.TypeOf(&ast.ParenExpr{})
+	reflectValue = reflect.TypeOf(reflect.Value{})
+)
+
+// normalize removes from the AST what the decompiler does not keep, the positions and the parentheses.
+// The comments are removed as well, they can move from a node to another.
+func normalize(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			normalize(v.Elem())
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		for v.Elem().Type() == parenType && v.CanSet() {
+			v.Set(v.Elem().Elem().FieldByName("SubExpr"))
+			if v.IsNil() {
+				return
+			}
+		}
+		normalize(v.Elem())
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			normalize(v.Index(i))
+		}
+	case reflect.Struct:
+		if v.Type() == posImplType || v.Type() == commentsType {
+			v.Set(reflect.Zero(v.Type()))
+			return
+		}
+		if v.Type() == reflectValue {
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Field(i).CanSet() {
+				normalize(v.Field(i))
+			}
+		}
+	}
+}
+
+func assertRoundTrip(t *testing.T, stmt ast.Stmt) string {
+	out, err := Decompile(stmt)
+	if !assert.NoError(t, err) {
+		return ""
+	}
+	stmt2, err := parser.ParseSrc(out)
+	if !assert.NoError(t, err, out) {
+		return out
+	}
+	normalize(reflect.ValueOf(&stmt).Elem())
+	normalize(reflect.ValueOf(&stmt2).Elem())
+	assert.Equal(t, stmt, stmt2, out)
+	return out
+}
+
+func TestDecompile_RoundTrip(t *testing.T) {
+	tests := []string{
+		`a = 1; b := "x\ty\"z"; mut c := 3; var d, e = 4, 5`,
+		`a, b = m["k"]`,
+		`a[1] = 2; a.b = c; a[1:2]; a[:2]; a[1:]`,
+		`a?.b?.c; m?["k"]?[0]; a?.b(1) ?? c`,
+		"func g(n) { for i in n { yield i * 2 } }; for x in g(a) { f(x) }",
+		`[a, b, ...rest] = l; {name, "age": n} = p; mut [x, [y]] := l; var [c, ...d] = l; var {e} = p; {"f": f}`,
+		"func f([a, b], {c}, d, [e]...) { for [k, v] in a {}; for {g} in b {} }",
+		`[...a, 1, ...(b + c)]; []int{...a}; {...d, "k": 1}; {a, ...rest} = m; f(...a, 1, ...b); f(...a, b...); defer f(...a)`,
+		"[x * 2 for x in a if x > 3]; {k: v for k, v in m}; [a + b for [a, b] in f(c) if a]; {x: [y for y in x] for x in (a + b)}",
+		"t := async f(a, ...b); u = async func() { return 1 }(); v = await t + 1; await all(t, ...l); await race(t, u) timeout 1m30s; await t timeout (d * 2)",
+		"with timeout(500ms) { a() }; with cancel(c) { with timeout(d * 2) {} }",
+		"enum Color { Red, Green, Blue }; switch c { case Color.Red: a() }",
+		`-a; !b; ^c; &d; *e; a++; b--; a += 1; a |= 2`,
+		`a + b * c; (a + b) * c; a == b; a != b; a && b || c; a ?? b; a in [1, 2]`,
+		`c ? a : b; (a ? b : c) ? d : e`,
+		`f(a, b...); f(); a.b(c); func(x) { return x }(1)`,
+		"func f(a, b int, mut c string) (int, error) { return a, nil }",
+		"func g(a, b...) { return }; func h(a... int) int { return 1 }; func k() () {}",
+		`[]; [1, [2]]; {}; {"a": 1, "b": {"c": 2}}; []int{1, 2}; map[string]int{"a": 1}`,
+		`make(int); make([]int, 1, 2); make(map[string][]int); make(chan bool); new(int); make(type T, 1)`,
+		`make(struct{a int, b *foo.Bar}); make(*[]int); make([][]string)`,
+		`len(a); close(c); delete(m); delete(m, "k"); c <- 1; <-c; v = <-c`,
+		`if a { b } else if c { d } else { e }`,
+		`if a {}`,
+		`for { break }; for a < 1 { continue }; for i in a {}; for k, v in m {}`,
+		`for i = 0; i < 10; i++ { }; for var i = 0; i < 3; i++ { }`,
+		"L1:\nfor { break L1; continue L1 }",
+		`try { throw "x" } catch e { a } finally { b }; try {} catch {}`,
+		`try { a } catch e in "x", (Point{}) { b } catch in 1 {} catch {}; try {} catch in e {} finally {}`,
+		`module m { a = 1; func b() {} }`,
+		`module m { export a = 1; export var b, c = 1, 2; export d, e := f(); export func g() {} }`,
+		"switch a {\ncase 1, 2:\n b\ncase 3:\ndefault:\n c\n}",
+		"select {\ncase v = <-c:\n a\ncase <-d:\ndefault:\n b\n}",
+		`go f(1); go func() {}(); defer f(); defer func() {}()`,
+		`dbg(); dbg(a)`,
+		"a = `raw\\n`",
+		"type Point struct { X int; Y int }\ntype Empty struct {}\ntype Ints []int",
+		`p = Point{X: 1, Y: 2}; q = Point{1, 2}; Point{}; Point{1, 2}.X; [Point{}]`,
+		`func (p Point) Dist() int { return p.X }; func (p *Point) Move(dx, dy) {}`,
+		`if p == (Point{1, 2}) { }; for a in (Point{}).X {}; switch (Point{}) {}`,
+		`import "./lib/util.ank" as util; import("strings")`,
+		`"a ${b} c"; "${f(1)}${m["k"]}"; "\\${x} $y"; '${z}'; "${"in ${a}"}"; if "${a}" == "" {}`,
+	}
+	for _, src := range tests {
+		t.Run(src, func(t *testing.T) {
+			stmt, err := parser.ParseSrc(src)
+			assert.NoError(t, err)
+			assertRoundTrip(t, stmt)
+		})
+	}
+}
+
+func TestDecompile_ExampleScripts(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "..", "_example", "scripts", "*.ank"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, files)
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			src, err := os.ReadFile(file)
+			assert.NoError(t, err)
+			stmt, err := parser.ParseSrc(string(src))
+			assert.NoError(t, err)
+			assertRoundTrip(t, stmt)
+		})
+	}
+}
+
+func TestDecompile_Optimized(t *testing.T) {
+	// The optimizer inlines the parentheses, they must be added back to keep the meaning of the expression
+	stmt, err := parser.ParseSrc("(a + b) * c; -(a + 1)")
+	assert.NoError(t, err)
+	out, err := Decompile(optimize.Optimize(stmt))
+	assert.NoError(t, err)
+	assert.Equal(t, "(a + b) * c\n-(a + 1)\n", out)
+}
+
+func TestDecompile_Comments(t *testing.T) {
+	src := "#!anko\n\n# doc of f\nfunc f(a) {\n  # inside\n  b = a + 1 # one\n  # end of f\n}\nif c { d } # after if\n# end"
+	expected := "#!anko\n# doc of f\nfunc f(a) {\n    # inside\n    b = a + 1 # one\n    # end of f\n}\nif c {\n    d\n}\n# after if\n# end\n"
+	stmt, err := parser.ParseSrc(src)
+	assert.NoError(t, err)
+	out := assertRoundTrip(t, stmt)
+	assert.Equal(t, expected, out)
+
+	// The comments are attached the same way to the decompiled source
+	stmt, err = parser.ParseSrc(out)
+	assert.NoError(t, err)
+	out, err = Decompile(stmt)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, out)
+
+	out, err = DecompileWithHooks(stmt, Hooks{})
+	assert.NoError(t, err)
+	assert.Equal(t, "func f(a) {\n    b = a + 1\n}\nif c {\n    d\n}\n", out)
+}
+
+type unknownExpr struct {
+	ast.ExprImpl
+}
+
+func TestDecompile_Unsupported(t *testing.T) {
+	_, err := Decompile(&ast.ExprStmt{Expr: &unknownExpr{}})
+	assert.EqualError(t, err, "decompile: unsupported expression *decompiler.unknownExpr")
+}
@@ -0,0 +1,68 @@
+// Package format implements the canonical formatting of anko source code.
+package format
+
+import (
+	"strings"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+	"github.com/alaingilbert/anko/pkg/decompiler"
+	"github.com/alaingilbert/anko/pkg/parser"
+)
+
+// Source formats the anko source code.
+// The code is printed from its AST by the decompiler, one statement per line and indented with 4 spaces,
+// with the comments the parser attached to the AST.
+// Blank lines between statements are kept, but never more than one in a row.
+func Source(src []byte) ([]byte, error) {
+	s := new(parser.Scanner)
+	s.Init(string(src))
+	stmt, err := parser.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(string(src), "\n")
+	blankBefore := func(line int) bool {
+		return line > 1 && line-2 < len(lines) && strings.TrimSpace(lines[line-2]) == ""
+	}
+	if stmt == nil {
+		return []byte(cleanBlankLines(writeComments(s.Comments(), blankBefore))), nil
+	}
+	out, err := decompiler.DecompileWithHooks(stmt, decompiler.CommentHooks(blankBefore))
+	if err != nil {
+		return nil, err
+	}
+	return []byte(cleanBlankLines(out)), nil
+}
+
+// writeComments writes the comments of a source without statements, each on its own line
+func writeComments(comments []*ast.Comment, blankBefore func(line int) bool) string {
+	var sb strings.Builder
+	for _, c := range comments {
+		if blankBefore(c.Position().Line) {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(c.Text + "\n")
+	}
+	return sb.String()
+}
+
+// cleanBlankLines removes the blank lines at the start and the end of the source and of the blocks
+func cleanBlankLines(src string) string {
+	src = strings.TrimSpace(src)
+	if src == "" {
+		return ""
+	}
+	lines := strings.Split(src, "\n")
+	out := make([]string, 0, len(lines))
+	for i, line := range lines {
+		if line == "" {
+			prev := strings.TrimSpace(out[len(out)-1])
+			next := strings.TrimSpace(lines[i+1])
+			if strings.HasSuffix(prev, "{") || next == "}" || next == "" {
+				continue
+			}
+		}
+		out = append(out, line)
+	}
+	return strings.Join(out, "\n") + "\n"
+}
@@ -0,0 +1,88 @@
+package format
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		expected string
+	}{
+		{name: "empty", src: "", expected: ""},
+		{name: "spaces", src: "a=1;b  =   a+2", expected: "a = 1\nb = a + 2\n"},
+		{name: "indent", src: "if a {\nb\n  if c {\n        d\n}\n}", expected: "if a {\n    b\n    if c {\n        d\n    }\n}\n"},
+		{name: "blank lines", src: "\n\na = 1\n\n\n\nb = 2\nc = 3\n\n", expected: "a = 1\n\nb = 2\nc = 3\n"},
+		{name: "only comments", src: "# a\n// b\n", expected: "# a\n// b\n"},
+		{
+			name:     "leading comments",
+			src:      "#!anko\n\n# first\n// second\na = 1\n/* block */\nb = 2",
+			expected: "#!anko\n\n# first\n// second\na = 1\n/* block */\nb = 2\n",
+		},
+		{name: "trailing comment", src: "a = 1   # one\nb = 2 // two", expected: "a = 1 # one\nb = 2 // two\n"},
+		{
+			name:     "comments in block",
+			src:      "func f() {\n# inside\na = 1\n    # end of block\n}\n# after\nf()",
+			expected: "func f() {\n    # inside\n    a = 1\n    # end of block\n}\n# after\nf()\n",
+		},
+		{
+			name:     "end of nested blocks",
+			src:      "if a {\n  if b {\n    c\n    # end of b\n  }\n  # end of a\n}\nd",
+			expected: "if a {\n    if b {\n        c\n        # end of b\n    }\n    # end of a\n}\nd\n",
+		},
+		{name: "final comment", src: "a = 1\n# the end", expected: "a = 1\n# the end\n"},
+		{
+			name:     "comment in else",
+			src:      "if a {\n  b\n} else {\n  # in else\n  c\n}",
+			expected: "if a {\n    b\n} else {\n    # in else\n    c\n}\n",
+		},
+		{
+			name:     "empty clauses",
+			src:      "switch a {\ncase 1:\n  # one\ncase 2: # two\ndefault:\n  # nothing\n}\nd\nswitch b {\ncase 1:\ndefault:\n}",
+			expected: "switch a {\ncase 1:\n    # one\ncase 2: # two\ndefault:\n    # nothing\n}\nd\nswitch b {\ncase 1:\ndefault:\n}\n",
+		},
+		{
+			name:     "empty blocks",
+			src:      "func f() {\n  # nothing yet\n}\nif a {\n} else {\n  # in else\n}",
+			expected: "func f() {\n    # nothing yet\n}\nif a {\n} else {\n    # in else\n}\n",
+		},
+		{
+			name:     "map elements",
+			src:      "m = {\n  # first\n  \"a\": 1,   # one\n  \"b\": 2}\nn = {\"c\": 3,\n\"d\": 4}",
+			expected: "m = {\n    # first\n    \"a\": 1, # one\n    \"b\": 2,\n}\nn = {\"c\": 3, \"d\": 4}\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := Source([]byte(tt.src))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(out))
+		})
+	}
+}
+
+func TestSource_Invalid(t *testing.T) {
+	_, err := Source([]byte("a ==== 1"))
+	assert.Error(t, err)
+}
+
+func TestSource_Idempotent(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "..", "_example", "scripts", "*.ank"))
+	assert.NoError(t, err)
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			src, err := os.ReadFile(file)
+			assert.NoError(t, err)
+			out, err := Source(src)
+			assert.NoError(t, err)
+			out2, err := Source(out)
+			assert.NoError(t, err)
+			assert.Equal(t, string(out), string(out2))
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package parser
+
+import (
+	"reflect"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+)
+
+// commentNode is a statement or an expression comments can be attached to
+type commentNode struct {
+	node  ast.Commented
+	start ast.Position // first position found in the node and its children
+	deep  int
+}
+
+// attachComments attaches the comments found by the scanner to the statements and expressions of the AST.
+// Consecutive comments are grouped, a group is attached:
+//   - as trailing comments of the innermost empty block it is written in;
+//   - as trailing comments of the outermost node starting before it on its line, when it follows some code;
+//   - as leading comments of the next node, when it is not indented deeper than that node;
+//   - else as trailing comments of the previous node, which is the case of the comments ending a block.
+func attachComments(stmt ast.Stmt, comments []*ast.Comment) {
+	if stmt == nil || len(comments) == 0 {
+		return
+	}
+	nodes := commentNodes(stmt)
+	if len(nodes) == 0 {
+		return
+	}
+	for _, group := range groupComments(comments) {
+		first := group.List[0].Position()
+		last := group.List[len(group.List)-1].Position()
+		if n := emptyBlock(nodes, first, last); n != nil {
+			addComments(n, group, true)
+			continue
+		}
+		if group.List[0].Trailing {
+			if n := lineNode(nodes, first); n != nil {
+				addComments(n, group, true)
+				continue
+			}
+		}
+		if n := nextNode(nodes, last); n != nil && first.Column <= n.start.Column {
+			addComments(n, group, false)
+		} else if n := prevNode(nodes, first); n != nil {
+			addComments(clauseBody(nodes, n), group, true)
+		} else if n := nextNode(nodes, last); n != nil {
+			addComments(n, group, false)
+		}
+	}
+}
+
+// commentNodes returns the nodes of the AST which have a position, parents before their children
+func commentNodes(stmt ast.Stmt) []*commentNode {
+	var nodes []*commentNode
+	walkNodes(reflect.ValueOf(stmt), 0, func(node ast.Commented, deep int) {
+		if stmts, ok := node.(*ast.StmtsStmt); ok && len(stmts.Stmts) > 0 {
+			return // a list of statements has no syntax of its own, the comments go to the statements
+		}
+		switch node.(type) {
+		case *ast.ExprsExpr, *ast.SelectBodyStmt:
+			return // nor have a list of expressions and the clauses of a select
+		}
+		if start := startPosition(node); start.Line > 0 {
+			nodes = append(nodes, &commentNode{node: node, start: start, deep: deep})
+		}
+	})
+	return nodes
+}
+
+// walkNodes calls fn with every statement and expression found in the value, parents before their children.
+// The parser cannot use astutil, it is walked with reflection.
+func walkNodes(v reflect.Value, deep int, fn func(ast.Commented, int)) {
+	switch v.Kind() {
+	case reflect.Interface:
+		if !v.IsNil() {
+			walkNodes(v.Elem(), deep, fn)
+		}
+	case reflect.Ptr:
+		if v.IsNil() {
+			return
+		}
+		if n, ok := v.Interface().(ast.Commented); ok {
+			fn(n, deep)
+			deep++
+		}
+		walkNodes(v.Elem(), deep, fn)
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			walkNodes(v.Index(i), deep, fn)
+		}
+	case reflect.Struct:
+		if v.Type() == reflectValueType {
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				walkNodes(v.Field(i), deep, fn)
+			}
+		}
+	}
+}
+
+var reflectValueType = reflect.TypeOf(reflect.Value{})
+
+// startPosition returns the first position found in the node and its children.
+// Some nodes have no position, or the one of a token in the middle of them, such as a call on a parenthesized function.
+// The literals only have a span, its start is used.
+func startPosition(node ast.Commented) ast.Position {
+	var start ast.Position
+	walkNodes(reflect.ValueOf(node), 0, func(n ast.Commented, _ int) {
+		if p, ok := n.(ast.Pos); ok {
+			pos := p.Position()
+			if pos.Line == 0 {
+				pos = p.Span().Start
+			}
+			if pos.Line > 0 && (start.Line == 0 || pos.Line < start.Line || (pos.Line == start.Line && pos.Column < start.Column)) {
+				start = pos
+			}
+		}
+	})
+	return start
+}
+
+// groupComments groups the consecutive comments, a comment following some code always starts a new group
+func groupComments(comments []*ast.Comment) []*ast.CommentGroup {
+	var groups []*ast.CommentGroup
+	var group *ast.CommentGroup
+	for i, c := range comments {
+		if group == nil || c.Trailing || comments[i-1].Trailing || c.Position().Line > comments[i-1].Position().Line+1 {
+			group = &ast.CommentGroup{}
+			groups = append(groups, group)
+		}
+		group.List = append(group.List, c)
+	}
+	return groups
+}
+
+// clauseBody returns the node of the body of the case clause if it is empty, the comments following the clause are
+// written in it. The given node is returned if it is not a case clause, or if the body of the clause is not empty.
+func clauseBody(nodes []*commentNode, n *commentNode) *commentNode {
+	var body ast.Stmt
+	switch clause := n.node.(type) {
+	case *ast.SwitchCaseStmt:
+		body = clause.Stmt
+	case *ast.SelectCaseStmt:
+		body = clause.Stmt
+	}
+	if stmts, ok := body.(*ast.StmtsStmt); ok && len(stmts.Stmts) == 0 {
+		for _, bodyNode := range nodes {
+			if bodyNode.node == stmts {
+				return bodyNode
+			}
+		}
+	}
+	return n
+}
+
+// emptyBlock returns the innermost empty block whose braces are around the positions, nil if there is none
+func emptyBlock(nodes []*commentNode, first, last ast.Position) *commentNode {
+	var out *commentNode
+	for _, n := range nodes {
+		stmts, ok := n.node.(*ast.StmtsStmt)
+		if !ok || len(stmts.Stmts) > 0 {
+			continue
+		}
+		span := stmts.Span()
+		if before(span.Start, first) && before(last, span.End) && (out == nil || n.deep > out.deep) {
+			out = n
+		}
+	}
+	return out
+}
+
+// before returns true if the position a is before the position b
+func before(a, b ast.Position) bool {
+	return a.Line < b.Line || (a.Line == b.Line && a.Column < b.Column)
+}
+
+// lineNode returns the outermost node starting on the line of the position, the last one if there are several
+func lineNode(nodes []*commentNode, pos ast.Position) *commentNode {
+	var out *commentNode
+	for _, n := range nodes {
+		if n.start.Line != pos.Line || n.start.Column >= pos.Column {
+			continue
+		}
+		if out == nil || n.deep < out.deep || (n.deep == out.deep && n.start.Column > out.start.Column) {
+			out = n
+		}
+	}
+	return out
+}
+
+// nextNode returns the outermost node starting first after the line of the position
+func nextNode(nodes []*commentNode, pos ast.Position) *commentNode {
+	var out *commentNode
+	for _, n := range nodes {
+		if n.start.Line <= pos.Line {
+			continue
+		}
+		if out == nil || n.start.Line < out.start.Line || (n.start.Line == out.start.Line && n.start.Column < out.start.Column) {
+			out = n
+		}
+	}
+	return out
+}
+
+// prevNode returns the outermost node starting last before the line of the position, the last one if there are
+// several on its line. The line must not be indented deeper than the position.
+func prevNode(nodes []*commentNode, pos ast.Position) *commentNode {
+	indents := make(map[int]int)
+	for _, n := range nodes {
+		if indent, ok := indents[n.start.Line]; !ok || n.start.Column < indent {
+			indents[n.start.Line] = n.start.Column
+		}
+	}
+	var out *commentNode
+	for _, n := range nodes {
+		if n.start.Line >= pos.Line || indents[n.start.Line] > pos.Column {
+			continue
+		}
+		if out == nil || n.start.Line > out.start.Line ||
+			(n.start.Line == out.start.Line && (n.deep < out.deep || (n.deep == out.deep && n.start.Column > out.start.Column))) {
+			out = n
+		}
+	}
+	return out
+}
+
+// addComments adds the comments of the group to the leading or the trailing comments of the node
+func addComments(n *commentNode, group *ast.CommentGroup, trailing bool) {
+	comments := n.node.Comments()
+	if comments == nil {
+		comments = &ast.Comments{}
+		n.node.SetComments(comments)
+	}
+	target := &comments.Leading
+	if trailing {
+		target = &comments.Trailing
+	}
+	if *target == nil {
+		*target = &ast.CommentGroup{}
+	}
+	(*target).List = append((*target).List, group.List...)
+}
@@ -0,0 +1,88 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttachComments(t *testing.T) {
+	src := `#!anko
+
+# doc of f
+// second line
+func f(a) {
+    # inside
+    b = a + 1 # one
+    # end of f
+}
+c = 1; d = 2 /* two */
+# the end`
+	stmt, err := ParseSrc(src)
+	assert.NoError(t, err)
+	stmts := stmt.(*ast.StmtsStmt).Stmts
+	assert.Nil(t, stmt.Comments())
+
+	fn := stmts[0].Comments()
+	assert.Equal(t, "!anko\ndoc of f\nsecond line", fn.Leading.Text())
+	assert.Nil(t, fn.Trailing)
+
+	body := stmts[0].(*ast.ExprStmt).Expr.(*ast.FuncExpr).Stmt.(*ast.StmtsStmt).Stmts
+	assert.Equal(t, "inside", body[0].Comments().Leading.Text())
+	assert.Equal(t, "one\nend of f", body[0].Comments().Trailing.Text())
+	assert.True(t, body[0].Comments().Trailing.List[0].Trailing)
+	assert.False(t, body[0].Comments().Trailing.List[1].Trailing)
+
+	assert.Nil(t, stmts[1].Comments())
+	assert.Equal(t, "two\nthe end", stmts[2].Comments().Trailing.Text())
+	assert.Equal(t, ast.Position{Line: 11, Column: 1}, stmts[2].Comments().Trailing.List[1].Position())
+}
+
+func TestAttachComments_NoCode(t *testing.T) {
+	s := new(Scanner)
+	s.Init("# only a comment")
+	stmt, err := Parse(s)
+	assert.NoError(t, err)
+	assert.Nil(t, stmt)
+	assert.Len(t, s.Comments(), 1)
+}
+
+func TestAttachComments_EmptyBlocks(t *testing.T) {
+	src := `if a {
+    b
+} else {
+    # in else
+}
+switch a {
+case 1:
+    # in case
+default:
+    # in default
+}`
+	stmt, err := ParseSrc(src)
+	assert.NoError(t, err)
+	stmts := stmt.(*ast.StmtsStmt).Stmts
+	ifStmt := stmts[0].(*ast.IfStmt)
+	assert.Nil(t, ifStmt.Then.(*ast.StmtsStmt).Stmts[0].Comments())
+	assert.Equal(t, "in else", ifStmt.Else.Comments().Trailing.Text())
+
+	switchStmt := stmts[1].(*ast.SwitchStmt)
+	assert.Equal(t, "in case", switchStmt.Cases[0].(*ast.SwitchCaseStmt).Stmt.Comments().Trailing.Text())
+	assert.Equal(t, "in default", switchStmt.Default.Comments().Trailing.Text())
+}
+
+func TestAttachComments_MapElements(t *testing.T) {
+	src := `m = {
+    # first
+    "a": 1, # one
+    "b": 2,
+}`
+	stmt, err := ParseSrc(src)
+	assert.NoError(t, err)
+	lets := stmt.(*ast.StmtsStmt).Stmts[0].(*ast.LetsStmt)
+	assert.Nil(t, lets.Comments())
+	m := lets.Rhss.(*ast.ExprsExpr).Exprs[0].(*ast.MapExpr)
+	assert.Equal(t, "first", m.Keys.Exprs[0].Comments().Leading.Text())
+	assert.Equal(t, "one", m.Values.Exprs[0].Comments().Trailing.Text())
+}
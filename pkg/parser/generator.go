@@ -0,0 +1,48 @@
+package parser
+
+import (
+	"reflect"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+)
+
+// hasYield returns true if the body of a function has a yield statement, which makes the function a generator.
+// The yield statements of the functions defined in the body belong to them.
+func hasYield(stmt ast.Stmt) bool {
+	found := false
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		if found {
+			return
+		}
+		switch v.Kind() {
+		case reflect.Interface, reflect.Ptr:
+			if v.IsNil() {
+				return
+			}
+			switch v.Interface().(type) {
+			case *ast.YieldStmt:
+				found = true
+				return
+			case *ast.FuncExpr:
+				return
+			}
+			walk(v.Elem())
+		case reflect.Slice:
+			for i := 0; i < v.Len(); i++ {
+				walk(v.Index(i))
+			}
+		case reflect.Struct:
+			if v.Type() == reflectValueType {
+				return
+			}
+			for i := 0; i < v.NumField(); i++ {
+				if v.Type().Field(i).IsExported() {
+					walk(v.Field(i))
+				}
+			}
+		}
+	}
+	walk(reflect.ValueOf(stmt))
+	return found
+}
@@ -4,7 +4,10 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/alaingilbert/anko/pkg/ast"
 )
@@ -21,6 +24,7 @@ const (
 type Error struct {
 	Message  string
 	Pos      ast.Position
+	Span     ast.Span // span of the token the error is at
 	Filename string
 	Fatal    bool
 }
@@ -30,12 +34,54 @@ func (e *Error) Error() string {
 	return e.Message
 }
 
+// ErrorList is a list of errors found in a source, sorted by position
+type ErrorList []*Error
+
+// Error returns the message of the first error, and the number of the other ones
+func (l ErrorList) Error() string {
+	switch len(l) {
+	case 0:
+		return "no errors"
+	case 1:
+		return l[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", l[0], len(l)-1)
+}
+
+// Unwrap returns the errors of the list
+func (l ErrorList) Unwrap() []error {
+	out := make([]error, len(l))
+	for i, err := range l {
+		out[i] = err
+	}
+	return out
+}
+
+// Err returns the list as an error, nil if it is empty
+func (l ErrorList) Err() error {
+	if len(l) == 0 {
+		return nil
+	}
+	return l
+}
+
 // Scanner stores information for lexer.
 type Scanner struct {
 	src      []rune
 	offset   int
 	lineHead int
 	line     int
+	comments []*ast.Comment
+	filename string
+	tokStart int             // offset of the last token scanned
+	prevTok  int             // last token scanned
+	ctrl     bool            // in the clause of a control statement, before its block
+	ctrlLvl  int             // nesting of parentheses and brackets in the clause of a control statement
+	sig      bool            // in the signature of a function, before its body
+	sigLvl   int             // nesting of parentheses in the signature of a function
+	patLvl   int             // nesting of brackets and braces in a destructuring pattern, 0 outside of one
+	patOpen  bool            // the bracket just scanned starts a destructuring pattern
+	interp   *ast.InterpExpr // string interpolation built by the last string scanned
 }
 
 // opName is correction of operation names.
@@ -78,13 +124,277 @@ var opName = map[string]int{
 // Init resets code to scan.
 func (s *Scanner) Init(src string) {
 	s.src = []rune(src)
+	s.comments = nil
+	s.prevTok, s.ctrl, s.ctrlLvl = 0, false, 0
+	s.sig, s.sigLvl, s.patLvl, s.patOpen = false, 0, 0, false
 }
 
 // Scan analyses token, and decide identify or literals.
 func (s *Scanner) Scan() (tok int, lit string, pos ast.Position, err error) {
+	tok, lit, pos, err = s.scan()
+	s.trackControlClause(tok)
+	s.trackSignature(tok)
+	s.trackPattern(tok)
+	s.prevTok = tok
+	return
+}
+
+// trackControlClause records if the scanner is in the clause of an if, for, switch or catch statement.
+// A brace following an identifier there opens the block of the statement, unless it is parenthesized.
+func (s *Scanner) trackControlClause(tok int) {
+	switch tok {
+	case IF, FOR, SWITCH, CATCH:
+		if (tok == IF || tok == FOR) && endsOperand(s.prevTok) {
+			return // clause of a comprehension, ex: [x for x in a if x > 1]
+		}
+		s.ctrl, s.ctrlLvl = true, 0
+	case '(', '[', OPTBRACKET, PATBRACKET:
+		s.ctrlLvl++
+	case ')', ']':
+		s.ctrlLvl--
+	case '{':
+		if s.ctrlLvl <= 0 {
+			s.ctrl = false
+		}
+	}
+}
+
+// trackSignature records if the scanner is in the signature of a function, whose parameters can be patterns.
+func (s *Scanner) trackSignature(tok int) {
+	switch tok {
+	case FUNC:
+		s.sig, s.sigLvl = true, 0
+	case '(':
+		s.sigLvl++
+	case ')':
+		if s.sigLvl--; s.sigLvl < 0 {
+			s.sig = false
+		}
+	case '{', '\n', ';':
+		if s.sigLvl <= 0 {
+			s.sig = false // body of the function, or a function type
+		}
+	}
+}
+
+// trackPattern records the nesting of the brackets and the braces of a destructuring pattern.
+// A brace nested in a pattern starts a map pattern.
+func (s *Scanner) trackPattern(tok int) {
+	switch tok {
+	case PATBRACKET, PATBRACE, PATMAP:
+		s.patLvl++
+	case '[', '{', '(', OPTBRACKET, STRUCTLIT:
+		if s.patLvl > 0 || s.patOpen {
+			s.patLvl++
+		}
+	case ']', '}', ')':
+		if s.patLvl > 0 {
+			s.patLvl--
+		}
+	}
+	s.patOpen = false
+}
+
+// endsOperand returns true if the token ends an operand, which cannot be followed by a statement.
+func endsOperand(tok int) bool {
+	switch tok {
+	case IDENT, NUMBER, STRING, INTERP, TRUE, FALSE, NIL, EMPTYARR, ')', ']', '}':
+		return true
+	}
+	return false
+}
+
+// isForPattern returns either or not the bracket or the brace just found starts the destructuring pattern of the
+// variable of a for-in loop, which is followed by the in keyword. Otherwise, it starts the condition or the block.
+func (s *Scanner) isForPattern() bool {
+	return s.prevTok == FOR && s.isPatternBeforeIn()
+}
+
+// isPatternStart returns either or not the bracket or the brace just found starts a destructuring pattern, out of
+// the ones of the for-in loops: nested in another pattern, following var or mut, as a parameter of a function,
+// or as a target of an assignment. ex: {name, age} = person
+func (s *Scanner) isPatternStart() bool {
+	switch {
+	case s.patLvl > 0, s.prevTok == VAR, s.prevTok == MUT:
+		return true
+	case s.sig && s.sigLvl == 1 && (s.prevTok == '(' || s.prevTok == ','):
+		return true
+	}
+	return s.isStmtStart() && s.isAssignedPattern()
+}
+
+// isPatternBeforeIn returns true if the brackets or the braces starting at the current offset are followed by the in
+// keyword.
+func (s *Scanner) isPatternBeforeIn() bool {
+	j := s.afterGroup()
+	end := j + len("in")
+	return j >= 0 && end <= len(s.src) && string(s.src[j:end]) == "in" && (end == len(s.src) || !isLetter(s.src[end]) && !isDigit(s.src[end]))
+}
+
+// isAssignedPattern returns true if the brackets or the braces starting at the current offset are followed by an
+// assignment, or by another of its targets. ex: {a, b} = m, [c], d := l, e
+func (s *Scanner) isAssignedPattern() bool {
+	j := s.afterGroup()
+	if j < 0 || j+1 >= len(s.src) {
+		return false
+	}
+	switch s.src[j] {
+	case ',':
+		return true
+	case '=':
+		return s.src[j+1] != '='
+	case ':':
+		return s.src[j+1] == '='
+	}
+	return false
+}
+
+// afterGroup returns the offset following the brackets, the braces or the parentheses starting at the current offset,
+// and the blanks after them. It is -1 if they are not closed.
+func (s *Scanner) afterGroup() int {
+	depth := 0
+	for i := s.offset; i < len(s.src); i++ {
+		switch s.src[i] {
+		case '[', '{', '(':
+			depth++
+		case ']', '}', ')':
+			if depth--; depth > 0 {
+				continue
+			}
+			j := i + 1
+			for j < len(s.src) && isBlank(s.src[j]) {
+				j++
+			}
+			return j
+		}
+	}
+	return -1
+}
+
+// isStructLitAllowed returns true if an identifier followed by a brace is the type of a struct literal.
+// It is not after the tokens ending a type or a signature, as in []T{} or func() T {}.
+func (s *Scanner) isStructLitAllowed() bool {
+	if s.ctrl && s.ctrlLvl <= 0 {
+		return false
+	}
+	switch s.prevTok {
+	case ']', EMPTYARR, '*', ')', '.', MAP, CHAN, STRUCT, TYPE, FUNC, MODULE, CATCH, ENUM:
+		return false
+	}
+	return true
+}
+
+// isImportStmt returns either or not the import identifier just scanned starts an import statement,
+// which is followed by the path of the module. Otherwise, it is the import function.
+func (s *Scanner) isImportStmt() bool {
+	for i := s.offset; i < len(s.src) && !isEOL(s.src[i]); i++ {
+		if !isBlank(s.src[i]) {
+			return s.src[i] == '"' || s.src[i] == '\'' || s.src[i] == '`'
+		}
+	}
+	return false
+}
+
+// isDeclStmt returns either or not the export or enum identifier just scanned starts a statement,
+// which is at the start of a statement and followed by the declaration of the exported symbols, or the name of the enum.
+func (s *Scanner) isDeclStmt() bool {
+	if !s.isStmtStart() {
+		return false
+	}
+	for i := s.offset; i < len(s.src) && !isEOL(s.src[i]); i++ {
+		if !isBlank(s.src[i]) {
+			return isLetter(s.src[i])
+		}
+	}
+	return false
+}
+
+// isWithStmt returns either or not the with identifier just scanned starts a with statement,
+// which is at the start of a statement and followed by timeout( or cancel(.
+func (s *Scanner) isWithStmt() bool {
+	if !s.isStmtStart() {
+		return false
+	}
+	i := s.offset
+	for i < len(s.src) && isBlank(s.src[i]) {
+		i++
+	}
+	start := i
+	for i < len(s.src) && isLetter(s.src[i]) {
+		i++
+	}
+	word := string(s.src[start:i])
+	for i < len(s.src) && isBlank(s.src[i]) {
+		i++
+	}
+	return (word == "timeout" || word == "cancel") && i < len(s.src) && s.src[i] == '('
+}
+
+// isStmtStart returns either or not the identifier just scanned is at the start of a statement.
+func (s *Scanner) isStmtStart() bool {
+	switch s.prevTok {
+	case 0, ';', '\n', '{':
+		return true
+	}
+	return false
+}
+
+// isOperandAhead returns either or not the yield, async or await identifier just scanned is followed by its operand,
+// on the same line, which makes it a keyword. ex: yield x, await t, async f()
+// A bracket or a unary operator starts the operand when it is separated from the identifier, ex: yield [a, b], yield -1.
+// Otherwise, the identifier is indexed, called or is an operand, ex: yield[0] = 1, yield(x), yield - 1.
+func (s *Scanner) isOperandAhead() bool {
+	i := s.offset
+	for i < len(s.src) && isBlank(s.src[i]) {
+		i++
+	}
+	if i == s.offset || i == len(s.src) {
+		return false
+	}
+	switch ch := s.src[i]; {
+	case isLetter(ch):
+		start := i
+		for i < len(s.src) && (isLetter(s.src[i]) || isDigit(s.src[i])) {
+			i++
+		}
+		switch string(s.src[start:i]) {
+		case "in", "for", "if", "else", "timeout":
+			return false // follows an operand, ex: yield in a
+		}
+		return true
+	case isDigit(ch), ch == '"', ch == '\'', ch == '`', ch == '[', ch == '(':
+		return true
+	case ch == '{':
+		return !s.ctrl || s.ctrlLvl > 0 // opens the block of a control statement otherwise
+	case ch == '-', ch == '!', ch == '^', ch == '&', ch == '*':
+		return i+1 < len(s.src) && startsOperand(s.src[i+1])
+	case ch == '<':
+		return i+2 < len(s.src) && s.src[i+1] == '-' && startsOperand(s.src[i+2])
+	}
+	return false
+}
+
+// isAsyncOperator returns either or not the async or await identifier just scanned is the operator, which starts an
+// operand and is followed by its own one. Otherwise, ex: async = 1, f(await), t.await, it is an identifier.
+func (s *Scanner) isAsyncOperator() bool {
+	switch s.prevTok {
+	case '.', OPTDOT:
+		return false
+	}
+	return !endsOperand(s.prevTok) && s.isOperandAhead()
+}
+
+// startsOperand returns true if the character starts an operand, following a unary operator.
+func startsOperand(ch rune) bool {
+	return isLetter(ch) || isDigit(ch) || ch == '"' || ch == '\'' || ch == '`' || ch == '(' || ch == '['
+}
+
+func (s *Scanner) scan() (tok int, lit string, pos ast.Position, err error) {
 retry:
 	s.skipBlank()
 	pos = s.pos()
+	start := s.offset
+	s.tokStart = start
 	switch ch := s.peek(); {
 	case isLetter(ch):
 		lit, err = s.scanIdentifier()
@@ -96,7 +406,7 @@ retry:
 		} else {
 			if lit == "for" {
 				s.next()
-				if s.peek() == '{' {
+				if s.peek() == '{' && !s.isPatternBeforeIn() {
 					tok = LOOP
 					lit = "for"
 				} else {
@@ -104,6 +414,31 @@ retry:
 					lit = "for"
 				}
 				s.back()
+			} else if s.peek() == '{' && s.isStructLitAllowed() {
+				tok = STRUCTLIT // the type of a struct literal, with its opening brace
+				s.next()
+			} else if lit == "import" && s.isImportStmt() {
+				tok = IMPORT
+			} else if lit == "export" && s.isDeclStmt() {
+				tok = EXPORT
+			} else if lit == "enum" && s.isDeclStmt() {
+				tok = ENUM
+			} else if lit == "with" && s.isWithStmt() {
+				tok = WITH
+			} else if lit == "yield" && s.isStmtStart() && s.isOperandAhead() {
+				tok = YIELD
+			} else if lit == "async" && s.isAsyncOperator() {
+				tok = ASYNC
+			} else if lit == "await" && s.isAsyncOperator() {
+				tok = AWAIT
+			} else if lit == "as" && s.prevTok == STRING {
+				tok = AS
+			} else if lit == "all" && s.prevTok == AWAIT && s.peek() == '(' {
+				tok = AWAITALL // ex: await all(t1, t2)
+			} else if lit == "race" && s.prevTok == AWAIT && s.peek() == '(' {
+				tok = AWAITRACE
+			} else if lit == "timeout" && endsOperand(s.prevTok) {
+				tok = TIMEOUT // ex: await t timeout 2s
 			} else {
 				tok = IDENT
 			}
@@ -114,12 +449,19 @@ retry:
 		if err != nil {
 			return
 		}
+		if isDuration(lit) {
+			tok = DURATION
+		}
 	case ch == '"':
 		tok = STRING
 		lit, err = s.scanString('"')
 		if err != nil {
 			return
 		}
+		if s.interp != nil {
+			tok = INTERP
+			lit = string(s.src[start:s.offset])
+		}
 	case ch == '\'':
 		tok = STRING
 		lit, err = s.scanString('\'')
@@ -140,6 +482,7 @@ retry:
 			for !isEOL(s.peek()) {
 				s.next()
 			}
+			s.addComment(start, pos)
 			goto retry
 		case '!':
 			s.next()
@@ -180,6 +523,12 @@ retry:
 			case '?':
 				tok = NILCOALESCE
 				lit = "??"
+			case '.':
+				tok = OPTDOT
+				lit = "?."
+			case '[':
+				tok = OPTBRACKET
+				lit = "?["
 			default:
 				s.back()
 				tok = int(ch)
@@ -237,6 +586,7 @@ retry:
 				for !isEOL(s.peek()) {
 					s.next()
 				}
+				s.addComment(start, pos)
 				goto retry
 			case '*':
 				for {
@@ -247,6 +597,7 @@ retry:
 
 					if s.peek() == '/' {
 						s.next()
+						s.addComment(start, pos)
 						goto retry
 					}
 
@@ -339,15 +690,29 @@ retry:
 			} else {
 				s.back()
 				tok = int(ch)
+				if s.isForPattern() {
+					tok = PATBRACKET
+				} else {
+					s.patOpen = s.isPatternStart()
+				}
 				lit = string(ch)
 			}
-		case '\n', '(', ')', ';', '%', '{', '}', ']', ',', '^':
+		case '{':
+			tok = int(ch)
+			if s.isForPattern() {
+				tok = PATBRACE
+			} else if s.isPatternStart() {
+				tok = PATMAP // ex: {name, age} = person
+			}
+			lit = string(ch)
+		case '\n', '(', ')', ';', '%', '}', ']', ',', '^':
 			tok = int(ch)
 			lit = string(ch)
 		default:
 			err = fmt.Errorf("syntax error on '%v' at %v:%v", string(ch), pos.Line, pos.Column)
 			tok = int(ch)
 			lit = string(ch)
+			s.next() // skip the character, for the parser to be able to recover from the error
 			return
 		}
 		s.next()
@@ -393,6 +758,34 @@ func appendNumberAndPoint(s *Scanner, ret []rune) []rune {
 	return ret
 }
 
+// addComment records the comment which starts at the given offset and ends at the current one
+func (s *Scanner) addComment(start int, pos ast.Position) {
+	c := &ast.Comment{Text: string(s.src[start:s.offset])}
+	for _, ch := range s.src[start-(pos.Column-1) : start] {
+		if !isBlank(ch) {
+			c.Trailing = true
+			break
+		}
+	}
+	c.SetPosition(pos)
+	s.comments = append(s.comments, c)
+}
+
+// SetFilename sets the identifier of the file being scanned, it is recorded in the spans of the tokens
+func (s *Scanner) SetFilename(filename string) {
+	s.filename = filename
+}
+
+// span returns the span of the last token scanned, which starts at the given position
+func (s *Scanner) span(pos ast.Position) ast.Span {
+	return ast.Span{File: s.filename, Start: pos, End: s.pos(), StartOffset: s.tokStart, EndOffset: s.offset}
+}
+
+// Comments returns the comments skipped while scanning
+func (s *Scanner) Comments() []*ast.Comment {
+	return s.comments
+}
+
 // peek returns current rune in the code.
 func (s *Scanner) peek() rune {
 	if s.reachEOF() {
@@ -490,12 +883,33 @@ func (s *Scanner) scanNumber() (string, error) {
 			ret = appendNumberAndPoint(s, ret)
 		}
 		if isLetter(s.peek()) {
-			return "", errors.New("identifier starts immediately after numeric literal")
+			return s.scanDuration(ret)
 		}
 	}
 	return string(ret), nil
 }
 
+// scanDuration returns the duration literal whose first number is already scanned, ex: 2s, 1.5h, 1h30m
+func (s *Scanner) scanDuration(ret []rune) (string, error) {
+	for isLetter(s.peek()) || isDigit(s.peek()) || s.peek() == '.' {
+		ret = append(ret, s.peek())
+		s.next()
+	}
+	if _, err := time.ParseDuration(string(ret)); err != nil {
+		return "", errors.New("identifier starts immediately after numeric literal")
+	}
+	return string(ret), nil
+}
+
+// isDuration returns true if the numeric literal is a duration, a number followed by time units
+func isDuration(lit string) bool {
+	if strings.HasPrefix(lit, "0x") || strings.HasPrefix(lit, "0b") || !isLetter(rune(lit[len(lit)-1])) {
+		return false
+	}
+	_, err := time.ParseDuration(lit)
+	return err == nil
+}
+
 // scanRawString returns raw-string starting at current position.
 func (s *Scanner) scanRawString(l rune) (string, error) {
 	var ret []rune
@@ -514,9 +928,10 @@ func (s *Scanner) scanRawString(l rune) (string, error) {
 }
 
 // scanString returns string starting at current position.
-// This handles backslash escaping.
+// This handles backslash escaping, and the interpolations of a double-quoted string, which are kept in s.interp.
 func (s *Scanner) scanString(l rune) (string, error) {
 	var ret []rune
+	s.interp = nil
 eos:
 	for {
 		s.next()
@@ -528,6 +943,26 @@ eos:
 		case l:
 			s.next()
 			break eos
+		case '$':
+			if l != '"' || s.offset+1 >= len(s.src) || s.src[s.offset+1] != '{' {
+				ret = append(ret, '$')
+				continue
+			}
+			s.next()
+			s.next()
+			expr, err := s.scanInterpExpr()
+			if err != nil {
+				for !isEOL(s.peek()) {
+					s.next() // skips the rest of the string, the scan resumes on the next line
+				}
+				return "", err
+			}
+			if s.interp == nil {
+				s.interp = &ast.InterpExpr{}
+			}
+			s.interp.Strs = append(s.interp.Strs, string(ret))
+			s.interp.Exprs = append(s.interp.Exprs, expr)
+			ret = nil
 		case '\\':
 			s.next()
 			switch s.peek() {
@@ -553,50 +988,189 @@ eos:
 			ret = append(ret, s.peek())
 		}
 	}
+	if s.interp != nil {
+		s.interp.Strs = append(s.interp.Strs, string(ret))
+	}
 	return string(ret), nil
 }
 
+// scanInterpExpr parses the expression of a string interpolation, which starts at the current position.
+// The scanner is moved to the closing brace of the interpolation.
+func (s *Scanner) scanInterpExpr() (ast.Expr, error) {
+	sub := &Scanner{src: s.src, offset: s.offset, lineHead: s.lineHead, line: s.line, filename: s.filename}
+	for depth := 0; ; {
+		tok, _, _, err := sub.Scan()
+		if err != nil {
+			return nil, err
+		}
+		if tok == EOF || tok == EOL {
+			return nil, errors.New("unterminated string interpolation")
+		}
+		if tok == '{' || tok == STRUCTLIT {
+			depth++
+		} else if tok == '}' {
+			if depth == 0 {
+				break
+			}
+			depth--
+		}
+	}
+	end := sub.tokStart
+	stmt, err := Parse(&Scanner{src: s.src[:end], offset: s.offset, lineHead: s.lineHead, line: s.line, filename: s.filename})
+	if err != nil {
+		return nil, err
+	}
+	for s.offset < end {
+		s.next()
+	}
+	if stmts, ok := stmt.(*ast.StmtsStmt); ok && len(stmts.Stmts) == 1 {
+		stmt = stmts.Stmts[0]
+	}
+	exprStmt, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return nil, errors.New("invalid expression in string interpolation")
+	}
+	return exprStmt.Expr, nil
+}
+
+// maxErrors is the number of errors after which a parse recovering from them stops
+const maxErrors = 10
+
 // Lexer provides interface to parse codes.
 type Lexer struct {
-	s    *Scanner
-	lit  string
-	pos  ast.Position
-	e    error
-	stmt ast.Stmt
+	s       *Scanner
+	tok     int
+	lit     string
+	pos     ast.Position
+	span    ast.Span
+	e       error
+	errs    []*Error // every error found when recovering
+	recover bool     // recover from the syntax errors, instead of stopping at the first one
+	stopped bool     // a syntax error was found, the parse is over when not recovering
+	eol     bool     // a newline was added at the end of the source, to recover from an error on the last line
+	stmt    ast.Stmt
 }
 
 // Lex scans the token and literals.
 func (l *Lexer) Lex(lval *yySymType) int {
+	if (l.stopped && !l.recover) || len(l.errs) >= maxErrors {
+		l.tok = 0
+		return 0 // end of the source, the parser is done
+	}
 	tok, lit, pos, err := l.s.Scan()
+	if tok == EOF && l.recover && len(l.errs) > 0 && !l.eol {
+		tok, l.eol = '\n', true // ends the last statement, for the parser to resynchronize on it
+	}
+	span := l.s.span(pos)
 	if err != nil {
-		l.e = &Error{Message: err.Error(), Pos: pos, Fatal: true}
+		e := &Error{Message: err.Error(), Pos: pos, Span: span, Filename: l.s.filename, Fatal: true}
+		var inner *Error
+		if errors.As(err, &inner) {
+			e.Pos, e.Span = inner.Pos, inner.Span // error in the expression of a string interpolation
+		}
+		l.addError(e)
+	}
+	if tok == INTERP {
+		lval.expr = l.s.interp
 	}
 	lval.tok = ast.Token{Tok: tok, Lit: lit}
 	lval.tok.SetPosition(pos)
+	if tok != '\n' && tok != ';' {
+		lval.tok.SetSpan(span)
+	}
+	l.tok = tok
 	l.lit = lit
 	l.pos = pos
+	l.span = span
 	return tok
 }
 
+// scannedToken is a lexer giving a token already scanned, for it to be translated like the parser does
+type scannedToken int
+
+func (t scannedToken) Lex(*yySymType) int { return int(t) }
+func (t scannedToken) Error(string)       {}
+
+// unexpected returns the message of a syntax error at the last token scanned. ex: unexpected '='
+// A character unknown to the grammar is named by itself, instead of $unk.
+func (l *Lexer) unexpected() string {
+	_, token := yylex1(scannedToken(l.tok), nil)
+	name := yyTokname(token)
+	if name == "$unk" && l.tok > 0 && l.tok <= utf8.MaxRune {
+		name = "'" + string(rune(l.tok)) + "'"
+	}
+	return "unexpected " + name
+}
+
 // Error sets parse error.
 func (l *Lexer) Error(msg string) {
-	l.e = &Error{Message: msg, Pos: l.pos, Fatal: false}
+	syntaxErr := strings.HasPrefix(msg, "syntax error")
+	if msg == "syntax error" {
+		msg = l.unexpected() // the parser does not name the token
+	}
+	msg = strings.TrimPrefix(msg, "syntax error: ")
+	if n := len(l.errs); l.recover && n > 0 && l.errs[n-1].Fatal && l.errs[n-1].Pos == l.pos {
+		return // the token could not be scanned, its error is already known
+	}
+	l.addError(&Error{Message: msg, Pos: l.pos, Span: l.span, Filename: l.s.filename, Fatal: false})
+	l.stopped = l.stopped || syntaxErr
+}
+
+// addError records an error. When not recovering, the last error found before the parse stops is kept.
+func (l *Lexer) addError(err *Error) {
+	if l.recover {
+		if l.e == nil {
+			l.e = err
+		}
+		l.errs = append(l.errs, err)
+	} else if !l.stopped {
+		l.e = err
+	}
 }
 
 // Parse provides way to parse the code using Scanner.
 func Parse(s *Scanner) (ast.Stmt, error) {
 	l := Lexer{s: s}
-	if yyParse(&l) != 0 {
+	if yyParse(&l) != 0 || l.stopped {
 		return nil, l.e
 	}
+	attachComments(l.stmt, s.comments)
 	return l.stmt, l.e
 }
 
+// ParseRecover parses the code using Scanner, recovering from the syntax errors at the statement boundaries.
+// Every error found is returned, along with the statements which could be parsed.
+func ParseRecover(s *Scanner) (ast.Stmt, ErrorList) {
+	l := Lexer{s: s, recover: true}
+	yyParse(&l)
+	if l.stmt != nil {
+		attachComments(l.stmt, s.comments)
+	}
+	return l.stmt, l.errs
+}
+
 // EnableErrorVerbose enabled verbose errors from the parser
 func EnableErrorVerbose() {
 	//yyErrorVerbose = true
 }
 
+// ParseFile parses the source of a file, the file name is recorded in the spans of the AST and in the errors.
+func ParseFile(fileName, src string) (ast.Stmt, error) {
+	scanner := &Scanner{
+		src:      []rune(src),
+		filename: fileName,
+	}
+	return Parse(scanner)
+}
+
+// ParseSrcRecover parses the code from source, recovering from the syntax errors. See ParseRecover.
+func ParseSrcRecover(src string) (ast.Stmt, ErrorList) {
+	scanner := &Scanner{
+		src: []rune(src),
+	}
+	return ParseRecover(scanner)
+}
+
 // ParseSrc provides way to parse the code from source.
 func ParseSrc(src string) (ast.Stmt, error) {
 	scanner := &Scanner{
@@ -13,6 +13,7 @@ import (
 %}
 
 %type<stmtsStmt> stmtsStmt
+%type<stmtsStmt> stmts_recover
 
 %type<stmts> stmt_select_cases
 %type<stmts> opt_stmt_select_cases
@@ -26,6 +27,8 @@ import (
 %type<stmt> stmt_try
 %type<stmt> stmt_defer
 %type<stmt> stmt_go
+%type<stmt> stmt_with
+%type<stmt> stmt_enum
 %type<stmt> stmt_if
 %type<stmt> stmt_loop
 %type<stmt> stmt_for
@@ -39,11 +42,14 @@ import (
 %type<stmt> stmt_return
 %type<stmt> stmt_continue
 %type<stmt> stmt_throw
+%type<stmt> stmt_yield
 %type<stmt> start
 %type<stmt> compstmt
 %type<stmt> block
 %type<stmt> opt_stmt_var_or_lets
 %type<stmt> opt_finally
+%type<stmt> catch_kinds_clause
+%type<stmts> catch_kinds_clauses
 %type<stmt> maybe_else
 %type<stmt> switch_content
 %type<stmt> stmt_switch_case
@@ -54,17 +60,25 @@ import (
 %type<stmt> stmt_select_default
 %type<stmt> stmt_select_opt_default
 %type<stmt> stmt_dbg
+%type<stmt> stmt_type
+%type<stmt> stmt_import
+%type<stmt> stmt_export
 %type<stmt> dbg_content
 
 %type<exprsExpr> exprs
 %type<exprsExpr> opt_exprs
+%type<exprsExpr> call_args
+%type<exprsExpr> opt_call_args
 %type<exprsExpr> element_list
 %type<exprsExpr> opt_element_list
 %type<exprs> expr_map_key_value
+%type<exprs> map_pattern_key_value
 
 %type<expr> expr
 %type<expr> array_length
 %type<expr> element
+%type<expr> pattern
+%type<expr> for_pattern
 %type<expr> key
 %type<expr> keyed_element
 %type<expr> literal_value
@@ -72,9 +86,15 @@ import (
 %type<expr> expr_member_or_ident
 %type<expr> expr_literals
 %type<expr> expr_unary
+%type<expr> expr_async
+%type<expr> expr_await
+%type<expr> await_tasks
 %type<expr> expr_ternary
 %type<expr> expr_func
 %type<expr> expr_array
+%type<expr> expr_comprehension
+%type<expr> comprehension_clause
+%type<expr> opt_comprehension_if
 %type<expr> expr_paren
 %type<expr> expr_binary
 %type<expr> expr_call
@@ -85,6 +105,8 @@ import (
 %type<expr> expr_new
 %type<expr> expr_make
 %type<expr> expr_map
+%type<expr> expr_map_pattern
+%type<expr> expr_struct
 %type<expr> expr_opchan
 %type<expr> expr_close
 %type<expr> expr_delete
@@ -96,9 +118,9 @@ import (
 %type<expr> opt_expr
 %type<expr> slice
 
-%type<str> unary_op
+%type<tok> unary_op
 %type<str> bin_op
-%type<str> op_assoc1
+%type<tok> op_assoc1
 
 %type<type_data> type
 %type<type_data> key_type
@@ -138,6 +160,8 @@ import (
 %type<expr_map> expr_map_container
 %type<expr_map> expr_map_content
 %type<expr_map> expr_map_content_helper
+%type<expr_map> map_pattern_content
+%type<expr_map> map_pattern_content_helper
 
 %type<slice_count> slice_count
 %type<expr_typed_ident> expr_typed_ident
@@ -156,13 +180,13 @@ import (
 	exprs                           []ast.Expr
 	stmts                           []ast.Stmt
 	stmt_select_content             *ast.SelectBodyStmt
-	expr_call_helper                struct{Exprs *ast.ExprsExpr; VarArg bool}
+	expr_call_helper                struct{Exprs *ast.ExprsExpr; VarArg bool; Span ast.Span}
 	expr_idents                     []string
 	func_expr_idents                []*ast.ParamExpr
 	func_expr_typed_ident           *ast.ParamExpr
 	func_expr_args                  struct{Params []*ast.ParamExpr; VarArg bool; TypeData *ast.TypeStruct}
 	expr_typed_ident                struct{Name string; TypeData *ast.TypeStruct}
-	stmt_lets_helper                struct{Exprs1, Exprs2 *ast.ExprsExpr; Typed, Mutable bool}
+	stmt_lets_helper                struct{Exprs1, Exprs2 *ast.ExprsExpr; Typed, Mutable bool; Span ast.Span}
 	opt_func_return_expr_idents     []*ast.FuncReturnValuesExpr
 	expr_map                        *ast.MapExpr
 	type_data                       *ast.TypeStruct
@@ -173,10 +197,12 @@ import (
 	op_lets                         bool
 }
 
-%token<tok> IDENT NUMBER STRING ARRAY VARARG FUNC RETURN VAR THROW IF ELSE FOR LOOP IN EQEQ NEQ GE LE OROR ANDAND NEW
+%token<tok> IDENT NUMBER STRING INTERP ARRAY VARARG FUNC RETURN VAR THROW IF ELSE FOR LOOP IN EQEQ NEQ GE LE OROR ANDAND NEW
             TRUE FALSE NIL NILCOALESCE MODULE TRY CATCH FINALLY PLUSEQ MINUSEQ MULEQ DIVEQ ANDEQ OREQ BREAK
             CONTINUE PLUSPLUS MINUSMINUS POW SHIFTLEFT SHIFTRIGHT SWITCH SELECT CASE DEFAULT GO DEFER CHAN MAKE
-            OPCHAN TYPE LEN DELETE CLOSE MAP STRUCT DBG WALRUS EMPTYARR MUT
+            OPCHAN TYPE LEN DELETE CLOSE MAP STRUCT DBG WALRUS EMPTYARR MUT STRUCTLIT IMPORT AS EXPORT
+            OPTDOT OPTBRACKET YIELD PATBRACKET PATBRACE DURATION ASYNC AWAIT AWAITALL AWAITRACE TIMEOUT WITH ENUM
+            PATMAP
 
 /* lowest precedence */
 %left POW
@@ -192,6 +218,8 @@ import (
 %right IN
 %right PLUSPLUS MINUSMINUS
 %right UNARY
+%right TIMEOUT
+%left OPTDOT OPTBRACKET '.' '(' '['
 /* highest precedence */
 /* https://golang.org/ref/spec#Expression */
 
@@ -205,15 +233,32 @@ start :
 		if l, ok := yylex.(*Lexer); ok {
 			l.stmt = $$
 		}
+		setSpan($$, spanOf($1))
+	}
+	| start error newline compstmt
+	{
+		$$ = appendStmts($1, $4)
+		if l, ok := yylex.(*Lexer); ok {
+			l.stmt = $$
+		}
 	}
 
 compstmt :
 	  opt_term                    { $$ = nil }
 	| opt_term stmtsStmt opt_term { $$ = $2  }
+	| opt_term stmts_recover      { $$ = $2  }
 
 stmtsStmt :
-	  stmt                { $$ = &ast.StmtsStmt{Stmts: []ast.Stmt{$1}} }
-	| stmtsStmt term stmt { $1.Stmts = append($1.Stmts, $3)            }
+	  stmt                { $$ = &ast.StmtsStmt{Stmts: []ast.Stmt{$1}}; setSpan($$, spanOf($1)) }
+	| stmtsStmt term stmt { $1.Stmts = append($1.Stmts, $3); setSpan($$, spanOf($1), spanOf($3)) }
+	| stmts_recover stmt  { $1.Stmts = append($1.Stmts, $2); setSpan($$, spanOf($1), spanOf($2)) }
+
+/* statements followed by a syntax error, the parser resynchronizes at the end of the statement in error.
+   The error token can follow a compstmt in a block, shifting it is preferred over reducing the compstmt. */
+stmts_recover :
+	  error term                { $$ = &ast.StmtsStmt{} }
+	| stmtsStmt term error term { $$ = $1 }
+	| stmts_recover error term  { $$ = $1 }
 
 stmt :
 	stmt_var_or_lets
@@ -222,6 +267,7 @@ stmt :
 	| stmt_continue
 	| stmt_return
 	| stmt_throw
+	| stmt_yield
 	| stmt_module
 	| stmt_if
 	| stmt_loop
@@ -231,8 +277,13 @@ stmt :
 	| stmt_select
 	| stmt_go
 	| stmt_defer
+	| stmt_with
 	| stmt_expr
 	| stmt_dbg
+	| stmt_type
+	| stmt_enum
+	| stmt_import
+	| stmt_export
 
 expr :
 	expr_iterable
@@ -247,18 +298,25 @@ expr :
 	| expr_opchan
 	| expr_close
 	| expr_delete
+	| expr_struct
+	| expr_async
+	| expr_await
+	| expr_map_pattern
 
 expr_iterable :
 	expr_map
 	| expr_paren
 	| expr_array
+	| expr_comprehension
 	| expr_anon_call
 	| expr_call
 	| expr_member_or_ident
 	| expr_item_or_slice
 	| expr_ternary
 
-block : '{' compstmt '}' { $$ = $2 }
+block :
+	  '{' compstmt '}'       { $$ = clauseStmt($<tok>1, $2); setSpan($$, $<tok>1.Span(), $<tok>3.Span()) }
+	| '{' compstmt error '}' { $$ = clauseStmt($<tok>1, $2); setSpan($$, $<tok>1.Span(), $<tok>4.Span()) }
 
 label : IDENT
 
@@ -267,6 +325,7 @@ labeled_stmt :
 	{
 		$4.SetLabel($1.Lit)
 		$$ = &ast.LabelStmt{Name: $1.Lit, Stmt: $4}
+		setSpan($$, $1.Span(), $<tok>2.Span(), spanOf($4))
 	}
 
 stmt_break :
@@ -274,10 +333,12 @@ stmt_break :
 	{
 		$$ = &ast.BreakStmt{}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span())
 	}
 	| BREAK label {
 		$$ = &ast.BreakStmt{Label: $2.Lit}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $2.Span())
 	}
 
 stmt_continue :
@@ -285,10 +346,12 @@ stmt_continue :
 	{
 		$$ = &ast.ContinueStmt{}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span())
 	}
 	| CONTINUE label {
 		$$ = &ast.ContinueStmt{Label: $2.Lit}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $2.Span())
 	}
 
 stmt_return :
@@ -296,6 +359,7 @@ stmt_return :
 	{
 		$$ = &ast.ReturnStmt{Exprs: $2}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2))
 	}
 
 stmt_throw :
@@ -303,6 +367,15 @@ stmt_throw :
 	{
 		$$ = &ast.ThrowStmt{Expr: $2}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2))
+	}
+
+stmt_yield :
+	YIELD expr
+	{
+		$$ = &ast.YieldStmt{Expr: $2}
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2))
 	}
 
 stmt_module :
@@ -310,6 +383,61 @@ stmt_module :
 	{
 		$$ = &ast.ModuleStmt{Name: $2.Lit, Stmt: $3}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $2.Span(), spanOf($3))
+	}
+
+stmt_type :
+	TYPE IDENT type
+	{
+		$$ = &ast.TypeStmt{Name: $2.Lit, TypeData: $3}
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $2.Span())
+	}
+
+stmt_enum :
+	ENUM IDENT '{' opt_newlines expr_idents opt_comma_opt_newlines '}'
+	{
+		seen := make(map[string]bool, len($5))
+		for _, name := range $5 {
+			if seen[name] {
+				yylex.Error("syntax error: enum " + $2.Lit + " declares " + name + " twice")
+			}
+			seen[name] = true
+		}
+		$$ = &ast.EnumStmt{Name: $2.Lit, Names: $5}
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $2.Span(), $<tok>7.Span())
+	}
+
+stmt_import :
+	IMPORT STRING AS IDENT
+	{
+		$$ = &ast.ImportStmt{Path: $2.Lit, Name: $4.Lit}
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $2.Span(), $3.Span(), $4.Span())
+	}
+
+stmt_export :
+	EXPORT stmt_var_or_lets
+	{
+		$$ = &ast.ExportStmt{Stmt: $2}
+		if $$.(*ast.ExportStmt).Names() == nil {
+			yylex.Error("syntax error: only symbols can be exported")
+		}
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2))
+	}
+	| EXPORT expr_func
+	{
+		stmt := &ast.ExprStmt{Expr: $2}
+		stmt.SetPosition($2.Position())
+		setSpan(stmt, spanOf($2))
+		$$ = &ast.ExportStmt{Stmt: stmt}
+		if $$.(*ast.ExportStmt).Names() == nil {
+			yylex.Error("syntax error: cannot export an anonymous function or a method")
+		}
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2))
 	}
 
 stmt_expr :
@@ -317,6 +445,7 @@ stmt_expr :
 	{
 		$$ = &ast.ExprStmt{Expr: $1}
 		$$.SetPosition($1.Position())
+		setSpan($$, spanOf($1))
 	}
 
 stmt_go :
@@ -329,6 +458,7 @@ stmt_go :
 		}
 		$$ = &ast.GoroutineStmt{Expr: $2}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2))
 	}
 
 stmt_defer :
@@ -341,13 +471,73 @@ stmt_defer :
 		}
 		$$ = &ast.DeferStmt{Expr: $2}
 		$$.SetPosition($2.Position())
+		setSpan($$, $1.Span(), spanOf($2))
+	}
+
+stmt_with :
+	WITH IDENT '(' expr ')' block
+	{
+		kind := ast.WithTimeout
+		if $2.Lit == "cancel" {
+			kind = ast.WithCancel
+		}
+		$$ = &ast.WithStmt{Kind: kind, Expr: $4, Stmt: $6}
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($6))
 	}
 
 stmt_try :
 	TRY block CATCH opt_ident block opt_finally
 	{
-		$$ = &ast.TryStmt{Try: $2, Var: $4.Lit, Catch: $5, Finally: $6}
+		var catchVar string
+		if $4 != nil {
+			catchVar = $4.Lit
+		}
+		$$ = &ast.TryStmt{Try: $2, Var: catchVar, Catch: $5, Finally: $6}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2), $3.Span(), spanOf($4), spanOf($5), spanOf($6))
+	}
+	| TRY block catch_kinds_clauses opt_finally
+	{
+		stmt := &ast.TryStmt{Try: $2, Finally: $4}
+		for _, c := range $3 {
+			stmt.Catches = append(stmt.Catches, c.(*ast.CatchStmt))
+		}
+		$$ = stmt
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2), spanOf($3[len($3)-1]), spanOf($4))
+	}
+	| TRY block catch_kinds_clauses CATCH opt_ident block opt_finally
+	{
+		stmt := &ast.TryStmt{Try: $2, Catch: $6, Finally: $7}
+		if stmt.Catch == nil {
+			stmt.Catch = &ast.StmtsStmt{} // the clause catching all the errors is there, its block is empty
+		}
+		for _, c := range $3 {
+			stmt.Catches = append(stmt.Catches, c.(*ast.CatchStmt))
+		}
+		if $5 != nil {
+			stmt.Var = $5.Lit
+		}
+		$$ = stmt
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2), $4.Span(), spanOf($6), spanOf($7))
+	}
+
+catch_kinds_clauses :
+	  catch_kinds_clause                     { $$ = []ast.Stmt{$1} }
+	| catch_kinds_clauses catch_kinds_clause { $$ = append($1, $2) }
+
+catch_kinds_clause :
+	CATCH opt_ident IN exprs block
+	{
+		stmt := &ast.CatchStmt{Kinds: $4.Exprs, Stmt: $5}
+		if $2 != nil {
+			stmt.Var = $2.Lit
+		}
+		$$ = stmt
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($4), spanOf($5))
 	}
 
 opt_finally :
@@ -383,6 +573,14 @@ stmt_var :
 			}
 		}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $<tok>3.Span(), spanOf($4))
+	}
+
+	| VAR pattern '=' expr
+	{
+		$$ = &ast.VarStmt{Pattern: $2, Exprs: []ast.Expr{$4}}
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $<tok>3.Span(), spanOf($4))
 	}
 
 stmt_lets :
@@ -398,22 +596,26 @@ stmt_lets :
 			}
 		}
 		if !isItem {
+			for _, e := range lhs.Exprs {
+				checkPattern(yylex, e, $1.Typed)
+			}
 			$$ = &ast.LetsStmt{Lhss: lhs, Operator: "=", Rhss: rhs, Typed: $1.Typed, Mutable: $1.Mutable}
 			if len(lhs.Exprs) != len(rhs.Exprs) && !(len(rhs.Exprs) == 1 && len(lhs.Exprs) > len(rhs.Exprs)) {
 				yylex.Error("unexpected ','")
 			}
 		}
 		$$.SetPosition(lhs.Exprs[0].Position())
+		setSpan($$, $1.Span)
 	}
 
 stmt_lets_helper :
 	exprs op_lets exprs
 	{
-		$$ = struct{Exprs1, Exprs2 *ast.ExprsExpr; Typed, Mutable bool}{Exprs1: $1, Exprs2: $3, Typed: $2, Mutable: false}
+		$$ = struct{Exprs1, Exprs2 *ast.ExprsExpr; Typed, Mutable bool; Span ast.Span}{Exprs1: $1, Exprs2: $3, Typed: $2, Mutable: false, Span: spanOf($1).Merge(spanOf($3))}
 	}
 	| MUT exprs WALRUS exprs
 	{
-		$$ = struct{Exprs1, Exprs2 *ast.ExprsExpr; Typed, Mutable bool}{Exprs1: $2, Exprs2: $4, Typed: true, Mutable: true}
+		$$ = struct{Exprs1, Exprs2 *ast.ExprsExpr; Typed, Mutable bool; Span ast.Span}{Exprs1: $2, Exprs2: $4, Typed: true, Mutable: true, Span: $1.Span().Merge(spanOf($4))}
 	}
 
 op_lets :
@@ -425,6 +627,7 @@ stmt_if :
 	{
 		$$ = &ast.IfStmt{If: $2, Then: $3, Else: $4}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2), spanOf($3), spanOf($4))
 	}
 
 maybe_else :
@@ -437,6 +640,7 @@ stmt_loop :
 	{
 		$$ = &ast.LoopStmt{Stmt: $2}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2))
 	}
 
 stmt_for :
@@ -451,24 +655,62 @@ stmt_for :
 		}
 		$$ = $2
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2), spanOf($3))
 	}
 
 for_content :
 	expr
 	{
 		$$ = &ast.LoopStmt{Expr: $1}
+		setSpan($$, spanOf($1))
 	}
 	| IDENT IN expr_iterable
 	{
 		$$ = &ast.ForStmt{Vars: []string{$1.Lit}, Value: $3}
+		setSpan($$, $1.Span(), $2.Span(), spanOf($3))
 	}
 	| IDENT ',' IDENT IN expr_iterable
 	{
 		$$ = &ast.ForStmt{Vars: []string{$1.Lit, $3.Lit}, Value: $5}
+		setSpan($$, $1.Span(), $<tok>2.Span(), $3.Span(), $4.Span(), spanOf($5))
+	}
+	| for_pattern IN expr_iterable
+	{
+		$$ = &ast.ForStmt{Pattern: $1, Value: $3}
+		setSpan($$, spanOf($1), $2.Span(), spanOf($3))
 	}
 	| opt_stmt_var_or_lets ';' opt_expr ';' opt_expr
 	{
 		$$ = &ast.CForStmt{Stmt1: $1, Expr2: $3, Expr3: $5}
+		setSpan($$, spanOf($1), $<tok>2.Span(), spanOf($3), $<tok>4.Span(), spanOf($5))
+	}
+
+for_pattern :
+	PATBRACKET element_list ']'
+	{
+		$$ = &ast.ArrayExpr{Exprs: $2}
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2), $<tok>3.Span())
+		checkPattern(yylex, $$, true)
+	}
+	| PATBRACE map_pattern_content '}'
+	{
+		$$ = $2
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2), $<tok>3.Span())
+		checkPattern(yylex, $$, true)
+	}
+
+pattern :
+	expr_array
+	{
+		$$ = $1
+		checkPattern(yylex, $$, true)
+	}
+	| expr_map_pattern
+	{
+		$$ = $1
+		checkPattern(yylex, $$, true)
 	}
 
 stmt_select :
@@ -476,12 +718,14 @@ stmt_select :
 	{
 		$$ = &ast.SelectStmt{Body: $3}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $<tok>2.Span(), spanOf($3), $<tok>4.Span())
 	}
 
 stmt_select_content :
 	opt_newlines opt_stmt_select_cases stmt_select_opt_default
 	{
 		$$ = &ast.SelectBodyStmt{Cases: $2, Default: $3}
+		setSpan($$, spanOf($3))
 	}
 
 opt_stmt_select_cases :
@@ -501,8 +745,9 @@ stmt_select_cases :
 stmt_select_case :
 	CASE stmt ':' compstmt
 	{
-		$$ = &ast.SelectCaseStmt{Expr: $2, Stmt: $4}
+		$$ = &ast.SelectCaseStmt{Expr: $2, Stmt: clauseStmt($1, $4)}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2), $<tok>3.Span(), spanOf($4))
 	}
 
 stmt_select_opt_default :
@@ -513,7 +758,7 @@ stmt_select_opt_default :
 stmt_select_default :
 	DEFAULT ':' compstmt
 	{
-		$$ = $3
+		$$ = clauseStmt($1, $3)
 	}
 
 stmt_switch :
@@ -522,12 +767,14 @@ stmt_switch :
 		$4.(*ast.SwitchStmt).Expr = $2
 		$$ = $4
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2), $<tok>3.Span(), spanOf($4), $<tok>5.Span())
 	}
 
 switch_content :
 	opt_newlines opt_stmt_switch_cases stmt_switch_opt_default
 	{
 		$$ = &ast.SwitchStmt{Cases: $2, Default: $3}
+		setSpan($$, spanOf($3))
 	}
 
 opt_stmt_switch_cases :
@@ -551,8 +798,9 @@ stmt_switch_cases :
 stmt_switch_case :
 	CASE opt_exprs ':' compstmt
 	{
-		$$ = &ast.SwitchCaseStmt{Exprs: $2, Stmt: $4}
+		$$ = &ast.SwitchCaseStmt{Exprs: $2, Stmt: clauseStmt($1, $4)}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2), $<tok>3.Span(), spanOf($4))
 	}
 
 stmt_switch_opt_default :
@@ -561,7 +809,7 @@ stmt_switch_opt_default :
 	| stmt_switch_default { $$ = $1 }
 
 stmt_switch_default :
-	DEFAULT ':' compstmt { $$ = $3 }
+	DEFAULT ':' compstmt { $$ = clauseStmt($1, $3) }
 
 opt_func_return_expr_idents :
 	{ $$ = nil }
@@ -607,6 +855,10 @@ func_expr_untyped_ident :
 	{
 		$$ = &ast.ParamExpr{Name: $1.Lit}
 	}
+	| pattern
+	{
+		$$ = &ast.ParamExpr{Pattern: $1}
+	}
 
 func_expr_typed_ident :
 	expr_typed_ident
@@ -639,8 +891,8 @@ opt_exprs :
 	| exprs       { $$ = $1  }
 
 exprs :
-	  expr                          { $$ = &ast.ExprsExpr{Exprs: []ast.Expr{$1}}  }
-	| exprs comma_opt_newlines expr { $1.Exprs = append($1.Exprs, $3) }
+	  expr                          { $$ = &ast.ExprsExpr{Exprs: []ast.Expr{$1}}; setSpan($$, spanOf($1)) }
+	| exprs comma_opt_newlines expr { $1.Exprs = append($1.Exprs, $3); setSpan($$, spanOf($1), spanOf($3)) }
 
 opt_expr :
 	/* nothing */ { $$ = nil }
@@ -651,11 +903,13 @@ stmt_dbg :
 	{
 		$$ = &ast.DbgStmt{Expr: nil}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $<tok>2.Span(), $<tok>3.Span())
 	}
 	| DBG '(' dbg_content ')'
 	{
 		$$ = $3
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $<tok>2.Span(), spanOf($3), $<tok>4.Span())
 	}
 
 dbg_content :
@@ -669,6 +923,7 @@ expr_len :
 	{
 		$$ = &ast.LenExpr{Expr: $3}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $<tok>2.Span(), spanOf($3), $<tok>4.Span())
 	}
 
 expr_paren :
@@ -676,13 +931,21 @@ expr_paren :
 	{
 		$$ = &ast.ParenExpr{SubExpr: $2}
 		if l, ok := yylex.(*Lexer); ok { $$.SetPosition(l.pos) }
+		setSpan($$, $<tok>1.Span(), spanOf($2), $<tok>3.Span())
 	}
 
-element : expr
+element :
+	expr
+	| VARARG expr
+	{
+		$$ = &ast.SpreadExpr{Expr: $2}
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2))
+	}
 
 element_list :
-	keyed_element                    { $$ = &ast.ExprsExpr{Exprs: []ast.Expr{$1}}  }
-	| element_list ',' keyed_element { $1.Exprs = append($1.Exprs, $3) }
+	keyed_element                    { $$ = &ast.ExprsExpr{Exprs: []ast.Expr{$1}}; setSpan($$, spanOf($1)) }
+	| element_list ',' keyed_element { $1.Exprs = append($1.Exprs, $3); setSpan($$, spanOf($1), $<tok>2.Span(), spanOf($3)) }
 
 opt_element_list :
 	/* nothing */  { $$ = nil }
@@ -702,6 +965,7 @@ composite_lit :
 		} else {
 			$$ = $2
 		}
+		setSpan($$, spanOf($2))
 	}
 
 literal_type : slice_type // array_type
@@ -723,18 +987,60 @@ expr_array :
 	{
 		$$ = &ast.ArrayExpr{Exprs: &ast.ExprsExpr{Exprs: []ast.Expr{}}}
 		if l, ok := yylex.(*Lexer); ok { $$.SetPosition(l.pos) }
+		setSpan($$, $1.Span())
 	}
 	| '[' element_list ']'
 	{
 		$$ = &ast.ArrayExpr{Exprs: $2}
 		if l, ok := yylex.(*Lexer); ok { $$.SetPosition(l.pos) }
+		setSpan($$, $<tok>1.Span(), spanOf($2), $<tok>3.Span())
+	}
+
+expr_comprehension :
+	'[' expr FOR comprehension_clause ']'
+	{
+		c := $4.(*ast.ComprehensionExpr)
+		c.Expr = $2
+		$$ = c
+		$$.SetPosition($<tok>1.Position())
+		setSpan($$, $<tok>1.Span(), spanOf($2), $3.Span(), spanOf($4), $<tok>5.Span())
+	}
+	| '{' opt_newlines expr ':' expr FOR comprehension_clause opt_newlines '}'
+	{
+		c := $7.(*ast.ComprehensionExpr)
+		c.Key, c.Expr = $3, $5
+		$$ = c
+		$$.SetPosition($<tok>1.Position())
+		setSpan($$, $<tok>1.Span(), spanOf($3), $<tok>4.Span(), spanOf($5), $6.Span(), spanOf($7), $<tok>9.Span())
 	}
 
+comprehension_clause :
+	IDENT IN expr_iterable opt_comprehension_if
+	{
+		$$ = &ast.ComprehensionExpr{Vars: []string{$1.Lit}, Value: $3, Cond: $4}
+		setSpan($$, $1.Span(), $2.Span(), spanOf($3), spanOf($4))
+	}
+	| IDENT ',' IDENT IN expr_iterable opt_comprehension_if
+	{
+		$$ = &ast.ComprehensionExpr{Vars: []string{$1.Lit, $3.Lit}, Value: $5, Cond: $6}
+		setSpan($$, $1.Span(), $<tok>2.Span(), $3.Span(), $4.Span(), spanOf($5), spanOf($6))
+	}
+	| for_pattern IN expr_iterable opt_comprehension_if
+	{
+		$$ = &ast.ComprehensionExpr{Pattern: $1, Value: $3, Cond: $4}
+		setSpan($$, spanOf($1), $2.Span(), spanOf($3), spanOf($4))
+	}
+
+opt_comprehension_if :
+	/* nothing */ { $$ = nil }
+	| IF expr     { $$ = $2  }
+
 expr_ternary :
 	expr '?' expr ':' expr
 	{
 		$$ = &ast.TernaryOpExpr{Expr: $1, Lhs: $3, Rhs: $5}
 		$$.SetPosition($1.Position())
+		setSpan($$, spanOf($1), $<tok>2.Span(), spanOf($3), $<tok>4.Span(), spanOf($5))
 	}
 
 expr_new :
@@ -747,6 +1053,7 @@ expr_new :
 			$$ = &ast.MakeExpr{TypeData: &ast.TypeStruct{Kind: ast.TypePtr, SubType: $3}}
 		}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $<tok>2.Span(), $<tok>4.Span())
 	}
 
 expr_opchan :
@@ -754,6 +1061,7 @@ expr_opchan :
 	{
 		$$ = &ast.ChanExpr{Rhs: $2}
 		$$.SetPosition($2.Position())
+		setSpan($$, $1.Span(), spanOf($2))
 	}
 
 expr_delete :
@@ -761,11 +1069,13 @@ expr_delete :
 	{
 		$$ = &ast.DeleteExpr{WhatExpr: $3}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $<tok>2.Span(), spanOf($3), $<tok>4.Span())
 	}
 	| DELETE '(' expr ',' expr ')'
 	{
 		$$ = &ast.DeleteExpr{WhatExpr: $3, KeyExpr: $5}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $<tok>2.Span(), spanOf($3), $<tok>4.Span(), spanOf($5), $<tok>6.Span())
 	}
 
 expr_close :
@@ -773,6 +1083,7 @@ expr_close :
 	{
 		$$ = &ast.CloseExpr{WhatExpr: $3}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $<tok>2.Span(), spanOf($3), $<tok>4.Span())
 	}
 
 expr_literals :
@@ -780,12 +1091,15 @@ expr_literals :
 	{
 		$$ = $1
 		$$.SetPosition($1.Position())
+		setSpan($$, spanOf($1))
 	}
 
 expr_literals_helper :
-	  NUMBER     { $$ = &ast.NumberExpr{Lit: $1.Lit} }
-	| STRING     { $$ = &ast.StringExpr{Lit: $1.Lit} }
-	| const_expr { $$ = &ast.ConstExpr{Value: $1.Lit} }
+	  NUMBER     { $$ = &ast.NumberExpr{Lit: $1.Lit}; setSpan($$, $1.Span()) }
+	| DURATION   { $$ = &ast.DurationExpr{Lit: $1.Lit}; setSpan($$, $1.Span()) }
+	| STRING     { $$ = &ast.StringExpr{Lit: $1.Lit}; setSpan($$, $1.Span()) }
+	| INTERP     { $$ = $<expr>1; $$.SetPosition($1.Position()); setSpan($$, $1.Span()) }
+	| const_expr { $$ = &ast.ConstExpr{Value: $1.Lit}; setSpan($$, $1.Span()) }
 
 const_expr : TRUE | FALSE | NIL
 
@@ -816,6 +1130,13 @@ expr_member :
 	{
 		$$ = &ast.MemberExpr{Expr: $1, Name: $3.Lit}
 		$$.SetPosition($1.Position())
+		setSpan($$, spanOf($1), $<tok>2.Span(), $3.Span())
+	}
+	| expr OPTDOT IDENT
+	{
+		$$ = &ast.MemberExpr{Expr: $1, Name: $3.Lit, Optional: true}
+		$$.SetPosition($1.Position())
+		setSpan($$, spanOf($1), $<tok>2.Span(), $3.Span())
 	}
 
 expr_callable :
@@ -827,6 +1148,7 @@ expr_call :
 	{
 		$$ = &ast.CallExpr{Name: $1.(*ast.IdentExpr).Lit, Callable: &ast.Callable{SubExprs: $2.Exprs, VarArg: $2.VarArg}}
 		$$.SetPosition($1.Position())
+		setSpan($$, spanOf($1), $2.Span)
 	}
 
 expr_anon_call :
@@ -834,41 +1156,96 @@ expr_anon_call :
 	{
 		$$ = &ast.AnonCallExpr{Expr: $1, Callable: &ast.Callable{SubExprs: $2.Exprs, VarArg: $2.VarArg}}
 		$$.SetPosition($1.Position())
+		setSpan($$, spanOf($1), $2.Span)
 	}
 
 expr_call_helper :
-	'(' exprs VARARG ')'
+	'(' call_args VARARG ')'
 	{
-		$$ = struct{Exprs *ast.ExprsExpr; VarArg bool}{Exprs: $2, VarArg: true}
+		if _, ok := $2.Exprs[len($2.Exprs)-1].(*ast.SpreadExpr); ok {
+			yylex.Error("syntax error: unexpected VARARG")
+		}
+		$$ = struct{Exprs *ast.ExprsExpr; VarArg bool; Span ast.Span}{Exprs: $2, VarArg: true, Span: $<tok>1.Span().Merge($<tok>4.Span())}
 	}
-	| '(' opt_exprs ')'
+	| '(' opt_call_args ')'
 	{
-		$$ = struct{Exprs *ast.ExprsExpr; VarArg bool}{Exprs: $2}
+		$$ = struct{Exprs *ast.ExprsExpr; VarArg bool; Span ast.Span}{Exprs: $2, Span: $<tok>1.Span().Merge($<tok>3.Span())}
 	}
 
+call_args :
+	  element                               { $$ = &ast.ExprsExpr{Exprs: []ast.Expr{$1}}; setSpan($$, spanOf($1)) }
+	| call_args comma_opt_newlines element { $1.Exprs = append($1.Exprs, $3); setSpan($$, spanOf($1), spanOf($3)) }
+
+opt_call_args :
+	/* nothing */ { $$ = &ast.ExprsExpr{Exprs: []ast.Expr{}} }
+	| call_args   { $$ = $1  }
+
 unary_op :
-	  '+' { $$ = "+" }
-	| '-' { $$ = "-" }
-	| '!' { $$ = "!" }
-	| '^' { $$ = "^" }
-	| '*' { $$ = "*" }
-	| '&' { $$ = "&" }
+	  '+' { $$ = $<tok>1; $$.Lit = "+" }
+	| '-' { $$ = $<tok>1; $$.Lit = "-" }
+	| '!' { $$ = $<tok>1; $$.Lit = "!" }
+	| '^' { $$ = $<tok>1; $$.Lit = "^" }
+	| '*' { $$ = $<tok>1; $$.Lit = "*" }
+	| '&' { $$ = $<tok>1; $$.Lit = "&" }
 
 expr_unary :
 	unary_op expr %prec UNARY
 	{
-		if $1 == "&" {
+		if $1.Lit == "&" {
 			if el, ok := $2.(*ast.IdentExpr); ok {
 				$$ = &ast.AddrExpr{Expr: el}
 			} else if el, ok := $2.(*ast.MemberExpr); ok {
 				$$ = el
 			}
-		} else if $1 == "*" {
+		} else if $1.Lit == "*" {
 			$$ = &ast.DerefExpr{Expr: $2}
 		} else {
-			$$ = &ast.UnaryExpr{Operator: $1, Expr: $2}
+			$$ = &ast.UnaryExpr{Operator: $1.Lit, Expr: $2}
 		}
 		$$.SetPosition($2.Position())
+		setSpan($$, $1.Span(), spanOf($2))
+	}
+
+expr_async :
+	ASYNC expr %prec UNARY
+	{
+		switch $2.(type) {
+		case *ast.CallExpr, *ast.AnonCallExpr:
+		default:
+			yylex.Error("syntax error: async needs a function call")
+		}
+		$$ = &ast.AsyncExpr{Expr: $2}
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2))
+	}
+
+expr_await :
+	await_tasks %prec UNARY
+	| await_tasks TIMEOUT expr %prec UNARY
+	{
+		$1.(*ast.AwaitExpr).Timeout = $3
+		$$ = $1
+		setSpan($$, spanOf($1), $2.Span(), spanOf($3))
+	}
+
+await_tasks :
+	AWAIT expr %prec UNARY
+	{
+		$$ = &ast.AwaitExpr{Kind: ast.AwaitTask, Exprs: []ast.Expr{$2}}
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2))
+	}
+	| AWAIT AWAITALL '(' opt_call_args ')'
+	{
+		$$ = &ast.AwaitExpr{Kind: ast.AwaitAll, Exprs: $4.Exprs}
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $2.Span(), $<tok>5.Span())
+	}
+	| AWAIT AWAITRACE '(' opt_call_args ')'
+	{
+		$$ = &ast.AwaitExpr{Kind: ast.AwaitRace, Exprs: $4.Exprs}
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $2.Span(), $<tok>5.Span())
 	}
 
 bin_op :
@@ -916,34 +1293,38 @@ expr_binary :
 			$$ = &ast.BinOpExpr{Lhs: $1, Operator: $2, Rhs: $3}
 		}
 		$$.SetPosition($1.Position())
+		setSpan($$, spanOf($1), spanOf($3))
 	}
 	| expr EQEQ expr
 	{
 		$$ = &ast.BinOpExpr{Lhs: $1, Operator: "==", Rhs: $3}
 		$$.SetPosition($1.Position())
+		setSpan($$, spanOf($1), $2.Span(), spanOf($3))
 	}
 	| expr IN expr
 	{
 		$$ = &ast.IncludeExpr{ItemExpr: $1, ListExpr: &ast.SliceExpr{Value: $3, Begin: nil, End: nil}}
 		$$.SetPosition($1.Position())
+		setSpan($$, spanOf($1), $2.Span(), spanOf($3))
 	}
 	| expr_assoc
 
 op_assoc1 :
-	PLUSPLUS     { $$ = "++" }
-	| MINUSMINUS { $$ = "--" }
+	PLUSPLUS     { $$ = $1; $$.Lit = "++" }
+	| MINUSMINUS { $$ = $1; $$.Lit = "--" }
 
 expr_assoc :
 	expr op_assoc1
 	{
-		$$ = &ast.AssocExpr{Lhs: $1, Operator: $2}
+		$$ = &ast.AssocExpr{Lhs: $1, Operator: $2.Lit}
 		$$.SetPosition($1.Position())
+		setSpan($$, spanOf($1), $2.Span())
 	}
 
 expr_func :
 	FUNC opt_ident '(' func_expr_args ')' opt_func_return_expr_idents block
 	{
-		f := &ast.FuncExpr{Params: $4.Params, Returns: $6, Stmt: $7, VarArg: $4.VarArg}
+		f := &ast.FuncExpr{Params: $4.Params, Returns: $6, Stmt: $7, VarArg: $4.VarArg, Generator: hasYield($7)}
 		if $4.TypeData != nil {
 			f.Params[len(f.Params)-1].TypeData = $4.TypeData
 		}
@@ -952,6 +1333,22 @@ expr_func :
 		}
 		$$ = f
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2), $<tok>3.Span(), $<tok>5.Span(), spanOf($7))
+	}
+	| FUNC opt_ident '(' func_expr_args ')' IDENT '(' func_expr_args ')' opt_func_return_expr_idents block
+	{
+		f := &ast.FuncExpr{Name: $6.Lit, Params: $8.Params, Returns: $10, Stmt: $11, VarArg: $8.VarArg, Generator: hasYield($11)}
+		if $8.TypeData != nil {
+			f.Params[len(f.Params)-1].TypeData = $8.TypeData
+		}
+		if $2 != nil || $4.VarArg || len($4.Params) != 1 || $4.Params[0].TypeData == nil {
+			yylex.Error("syntax error: invalid method receiver")
+		} else {
+			f.Receiver = $4.Params[0]
+		}
+		$$ = f
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $<tok>3.Span(), $<tok>5.Span(), $6.Span(), spanOf($11))
 	}
 
 func_expr_args :
@@ -973,21 +1370,25 @@ expr_make :
 	{
 		$$ = &ast.MakeExpr{TypeData: $3}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $<tok>2.Span(), $<tok>4.Span())
 	}
 	| MAKE '(' type ',' expr ')'
 	{
 		$$ = &ast.MakeExpr{TypeData: $3, LenExpr: $5}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $<tok>2.Span(), $<tok>4.Span(), spanOf($5), $<tok>6.Span())
 	}
 	| MAKE '(' type ',' expr ',' expr ')'
 	{
 		$$ = &ast.MakeExpr{TypeData: $3, LenExpr: $5, CapExpr: $7}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $<tok>2.Span(), $<tok>4.Span(), spanOf($5), $<tok>6.Span(), spanOf($7), $<tok>8.Span())
 	}
 	| MAKE '(' TYPE IDENT ',' expr ')'
 	{
 		$$ = &ast.MakeTypeExpr{Name: $4.Lit, Type: $6}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $<tok>2.Span(), $3.Span(), $4.Span(), $<tok>5.Span(), spanOf($6), $<tok>7.Span())
 	}
 
 type :
@@ -1054,7 +1455,11 @@ map_type :
 	}
 
 type_struct_content :
-	opt_newlines type_data_struct opt_newlines
+	opt_newlines
+	{
+		$$ = &ast.TypeStruct{Kind: ast.TypeStructType}
+	}
+	| opt_newlines type_data_struct opt_term
 	{
 		$$ = $2
 	}
@@ -1072,6 +1477,11 @@ type_data_struct :
 		$$.StructNames = append($$.StructNames, $3.Name)
 		$$.StructTypes = append($$.StructTypes, $3.TypeData)
 	}
+	| type_data_struct term expr_typed_ident
+	{
+		$$.StructNames = append($$.StructNames, $3.Name)
+		$$.StructTypes = append($$.StructTypes, $3.TypeData)
+	}
 
 typed_slice_count :
 	slice_count type
@@ -1095,17 +1505,21 @@ expr_map :
 		$2.TypeData = $1
 		$$ = $2
 		$$.SetPosition($2.Position())
+		setSpan($$, spanOf($2))
 	}
 	| expr_map_container
 	{
 		$$ = $1
 		$$.SetPosition($1.Position())
+		setSpan($$, spanOf($1))
 	}
 
 expr_map_container :
 	'{' expr_map_content '}'
 	{
 		$$ = $2
+		$$.SetPosition($<tok>1.Position())
+		setSpan($$, $<tok>1.Span(), spanOf($2), $<tok>3.Span())
 	}
 
 expr_map_content :
@@ -1127,6 +1541,7 @@ expr_map_content_helper :
 	{
 		$$.Keys.Exprs = append($$.Keys.Exprs, $3[0])
 		$$.Values.Exprs = append($$.Values.Exprs, $3[1])
+		setSpan($$, spanOf($1))
 	}
 
 expr_map_key_value :
@@ -1134,6 +1549,84 @@ expr_map_key_value :
 	{
 		$$ = []ast.Expr{$1, $3}
 	}
+	| VARARG expr
+	{
+		spread := &ast.SpreadExpr{Expr: $2}
+		spread.SetPosition($1.Position())
+		setSpan(spread, $1.Span(), spanOf($2))
+		$$ = []ast.Expr{spread, nil}
+	}
+
+expr_map_pattern :
+	PATMAP map_pattern_content '}'
+	{
+		$$ = $2
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), spanOf($2), $<tok>3.Span())
+	}
+
+map_pattern_content :
+	opt_newlines
+	{
+		$$ = &ast.MapExpr{Keys: &ast.ExprsExpr{Exprs: []ast.Expr{}}, Values: &ast.ExprsExpr{Exprs: []ast.Expr{}}}
+	}
+	| opt_newlines map_pattern_content_helper opt_comma_opt_newlines
+	{
+		$$ = $2
+	}
+
+map_pattern_content_helper :
+	map_pattern_key_value
+	{
+		$$ = &ast.MapExpr{Keys: &ast.ExprsExpr{Exprs: []ast.Expr{$1[0]}}, Values: &ast.ExprsExpr{Exprs: []ast.Expr{$1[1]}}}
+	}
+	| map_pattern_content_helper comma_opt_newlines map_pattern_key_value
+	{
+		$$.Keys.Exprs = append($$.Keys.Exprs, $3[0])
+		$$.Values.Exprs = append($$.Values.Exprs, $3[1])
+		setSpan($$, spanOf($1))
+	}
+
+map_pattern_key_value :
+	expr_map_key_value
+	| IDENT
+	{
+		key := &ast.StringExpr{Lit: $1.Lit}
+		value := &ast.IdentExpr{Lit: $1.Lit}
+		key.SetPosition($1.Position())
+		value.SetPosition($1.Position())
+		setSpan(key, $1.Span())
+		setSpan(value, $1.Span())
+		$$ = []ast.Expr{key, value}
+	}
+
+expr_struct :
+	STRUCTLIT opt_newlines '}'
+	{
+		$$ = &ast.StructExpr{TypeData: &ast.TypeStruct{Name: $1.Lit}, Values: &ast.ExprsExpr{Exprs: []ast.Expr{}}}
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $<tok>3.Span())
+	}
+	| STRUCTLIT opt_newlines exprs opt_comma_opt_newlines '}'
+	{
+		$$ = &ast.StructExpr{TypeData: &ast.TypeStruct{Name: $1.Lit}, Values: $3}
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $<tok>5.Span())
+	}
+	| STRUCTLIT opt_newlines expr_map_content_helper opt_comma_opt_newlines '}'
+	{
+		e := &ast.StructExpr{TypeData: &ast.TypeStruct{Name: $1.Lit}, Values: $3.Values}
+		for _, key := range $3.Keys.Exprs {
+			if ident, ok := key.(*ast.IdentExpr); ok {
+				e.Names = append(e.Names, ident.Lit)
+			} else {
+				yylex.Error("syntax error: invalid field name in struct literal")
+			}
+		}
+		$$ = e
+		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span(), $<tok>5.Span())
+	}
 
 expr_item_or_slice :
 	expr '[' slice ']'
@@ -1145,13 +1638,20 @@ expr_item_or_slice :
 		}
 		$$ = $3
                	$$.SetPosition($1.Position())
+		setSpan($$, spanOf($1), $<tok>2.Span(), spanOf($3), $<tok>4.Span())
+	}
+	| expr OPTBRACKET expr ']'
+	{
+		$$ = &ast.ItemExpr{Value: $1, Index: $3, Optional: true}
+		$$.SetPosition($1.Position())
+		setSpan($$, spanOf($1), $<tok>2.Span(), spanOf($3), $<tok>4.Span())
 	}
 
 slice :
-	  expr ':' expr { $$ = &ast.SliceExpr{Begin: $1, End: $3}  }
-	| expr ':'      { $$ = &ast.SliceExpr{Begin: $1, End: nil} }
-	|      ':' expr { $$ = &ast.SliceExpr{Begin: nil, End: $2} }
-	| expr          { $$ = &ast.ItemExpr{Index: $1}            }
+	  expr ':' expr { $$ = &ast.SliceExpr{Begin: $1, End: $3}; setSpan($$, spanOf($1), $<tok>2.Span(), spanOf($3)) }
+	| expr ':'      { $$ = &ast.SliceExpr{Begin: $1, End: nil}; setSpan($$, spanOf($1), $<tok>2.Span()) }
+	|      ':' expr { $$ = &ast.SliceExpr{Begin: nil, End: $2}; setSpan($$, $<tok>1.Span(), spanOf($2)) }
+	| expr          { $$ = &ast.ItemExpr{Index: $1}; setSpan($$, spanOf($1)) }
 
 expr_idents :
 	expr_ident
@@ -1168,6 +1668,7 @@ expr_ident :
 	{
 		$$ = &ast.IdentExpr{Lit: $1.Lit}
 		$$.SetPosition($1.Position())
+		setSpan($$, $1.Span())
 	}
 
 comma : ','
@@ -1,10 +1,192 @@
 package parser
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+	"github.com/stretchr/testify/assert"
+)
 
 func FuzzParseSrc(f *testing.F) {
 	f.Add("")
+	f.Add("try { a } catch { b }")
 	f.Fuzz(func(t *testing.T, s string) {
 		_, _ = ParseSrc(s)
 	})
 }
+
+func TestParseSrc_Import(t *testing.T) {
+	stmt, err := ParseSrc("import \"./lib/util.ank\" as util\ns = import(\"strings\")\nas = 1")
+	assert.NoError(t, err)
+	stmts := stmt.(*ast.StmtsStmt).Stmts
+	assert.Equal(t, "./lib/util.ank", stmts[0].(*ast.ImportStmt).Path)
+	assert.Equal(t, "util", stmts[0].(*ast.ImportStmt).Name)
+	call := stmts[1].(*ast.LetsStmt).Rhss.(*ast.ExprsExpr).Exprs[0].(*ast.CallExpr)
+	assert.Equal(t, "import", call.Name)
+	assert.IsType(t, &ast.LetsStmt{}, stmts[2])
+
+	_, err = ParseSrc(`import "./lib/util.ank"`)
+	assert.EqualError(t, err, "unexpected $end")
+	_, err = ParseSrc(`import "./lib/util.ank" as "util"`)
+	assert.EqualError(t, err, "unexpected STRING")
+}
+
+func TestParseSrc_Export(t *testing.T) {
+	stmt, err := ParseSrc("export func f() {}\nexport a, b = 1, 2\nexport var c = 3\nexport = 1\nexport(1)")
+	assert.NoError(t, err)
+	stmts := stmt.(*ast.StmtsStmt).Stmts
+	assert.Equal(t, []string{"f"}, stmts[0].(*ast.ExportStmt).Names())
+	assert.Equal(t, []string{"a", "b"}, stmts[1].(*ast.ExportStmt).Names())
+	assert.Equal(t, []string{"c"}, stmts[2].(*ast.ExportStmt).Names())
+	assert.IsType(t, &ast.LetsStmt{}, stmts[3])
+	assert.Equal(t, "export", stmts[4].(*ast.ExprStmt).Expr.(*ast.CallExpr).Name)
+
+	_, err = ParseSrc(`export 1`)
+	assert.EqualError(t, err, "unexpected NUMBER")
+	_, err = ParseSrc(`export a[0] = 1`)
+	assert.EqualError(t, err, "only symbols can be exported")
+	_, err = ParseSrc(`export func (p P) f() {}`)
+	assert.EqualError(t, err, "cannot export an anonymous function or a method")
+}
+
+func TestParseSrc_Pattern(t *testing.T) {
+	stmt, err := ParseSrc("for [k, v] in a { }\nfor {name} in b { }\nfor { break }")
+	assert.NoError(t, err)
+	stmts := stmt.(*ast.StmtsStmt).Stmts
+	assert.IsType(t, &ast.ArrayExpr{}, stmts[0].(*ast.ForStmt).Pattern)
+	m := stmts[1].(*ast.ForStmt).Pattern.(*ast.MapExpr)
+	assert.Equal(t, "name", m.Keys.Exprs[0].(*ast.StringExpr).Lit)
+	assert.Equal(t, "name", m.Values.Exprs[0].(*ast.IdentExpr).Lit)
+	assert.IsType(t, &ast.LoopStmt{}, stmts[2])
+
+	// {name} is short for {"name": name} in the map patterns only
+	stmt, err = ParseSrc("{a, \"b\": [c, {d}]} = m\nmut {e} := m\nfunc f({g}) {}")
+	assert.NoError(t, err)
+	stmts = stmt.(*ast.StmtsStmt).Stmts
+	m = stmts[0].(*ast.LetsStmt).Lhss.(*ast.ExprsExpr).Exprs[0].(*ast.MapExpr)
+	assert.Equal(t, "a", m.Values.Exprs[0].(*ast.IdentExpr).Lit)
+	d := m.Values.Exprs[1].(*ast.ArrayExpr).Exprs.Exprs[1].(*ast.MapExpr)
+	assert.Equal(t, "d", d.Keys.Exprs[0].(*ast.StringExpr).Lit)
+	assert.IsType(t, &ast.LetsStmt{}, stmts[1])
+	_, err = ParseSrc("x = {a, b}")
+	assert.EqualError(t, err, "unexpected ','")
+	_, err = ParseSrc("f({a})")
+	assert.EqualError(t, err, "unexpected '}'")
+
+	_, err = ParseSrc("func f([a, 1]) {}")
+	assert.EqualError(t, err, "invalid destructuring pattern")
+	_, err = ParseSrc("for [a, ...b, c] in d {}")
+	assert.EqualError(t, err, "invalid destructuring pattern")
+}
+
+func TestParseSrc_Comprehension(t *testing.T) {
+	stmt, err := ParseSrc("a = [x * 2 for x in b if x > 3]\nc = {k: v for k, v in d}\np = P{1}\nif e == [y for [y] in f] { }")
+	assert.NoError(t, err)
+	stmts := stmt.(*ast.StmtsStmt).Stmts
+	rhs := func(stmt ast.Stmt) ast.Expr { return stmt.(*ast.LetsStmt).Rhss.(*ast.ExprsExpr).Exprs[0] }
+	list := rhs(stmts[0]).(*ast.ComprehensionExpr)
+	assert.Nil(t, list.Key)
+	assert.Equal(t, []string{"x"}, list.Vars)
+	assert.IsType(t, &ast.BinOpExpr{}, list.Cond)
+	m := rhs(stmts[1]).(*ast.ComprehensionExpr)
+	assert.Equal(t, "k", m.Key.(*ast.IdentExpr).Lit)
+	assert.Equal(t, []string{"k", "v"}, m.Vars)
+	assert.Nil(t, m.Cond)
+	// the control clauses of a comprehension do not change how the braces following it are read
+	assert.IsType(t, &ast.StructExpr{}, rhs(stmts[2]))
+	ifStmt := stmts[3].(*ast.IfStmt)
+	assert.IsType(t, &ast.ArrayExpr{}, ifStmt.If.(*ast.BinOpExpr).Rhs.(*ast.ComprehensionExpr).Pattern)
+
+	_, err = ParseSrc("[x for [x, 1] in a]")
+	assert.EqualError(t, err, "invalid destructuring pattern")
+}
+
+func TestParseSrc_Async(t *testing.T) {
+	stmt, err := ParseSrc("t = async f(1)\nv = await t timeout 2s\nr = await race(t, ...ts)\ntimeout = 1m30s + timeout")
+	assert.NoError(t, err)
+	stmts := stmt.(*ast.StmtsStmt).Stmts
+	rhs := func(stmt ast.Stmt) ast.Expr { return stmt.(*ast.LetsStmt).Rhss.(*ast.ExprsExpr).Exprs[0] }
+	assert.IsType(t, &ast.CallExpr{}, rhs(stmts[0]).(*ast.AsyncExpr).Expr)
+	await := rhs(stmts[1]).(*ast.AwaitExpr)
+	assert.Equal(t, ast.AwaitTask, await.Kind)
+	assert.Equal(t, "2s", await.Timeout.(*ast.DurationExpr).Lit)
+	race := rhs(stmts[2]).(*ast.AwaitExpr)
+	assert.Equal(t, ast.AwaitRace, race.Kind)
+	assert.Len(t, race.Exprs, 2)
+	assert.Nil(t, race.Timeout)
+	// timeout is only a keyword following the tasks of an await
+	sum := rhs(stmts[3]).(*ast.BinOpExpr)
+	assert.Equal(t, "1m30s", sum.Lhs.(*ast.DurationExpr).Lit)
+	assert.Equal(t, "timeout", sum.Rhs.(*ast.IdentExpr).Lit)
+
+	// async and await are only keywords followed by their operand
+	stmt, err = ParseSrc("async = 1\nawait = async + 1\nf(await, t.async)")
+	assert.NoError(t, err)
+	stmts = stmt.(*ast.StmtsStmt).Stmts
+	assert.Equal(t, "async", rhs(stmts[1]).(*ast.BinOpExpr).Lhs.(*ast.IdentExpr).Lit)
+	args := stmts[2].(*ast.ExprStmt).Expr.(*ast.CallExpr).SubExprs.Exprs
+	assert.Equal(t, "await", args[0].(*ast.IdentExpr).Lit)
+	assert.Equal(t, "async", args[1].(*ast.MemberExpr).Name)
+
+	_, err = ParseSrc("t = async 1")
+	assert.EqualError(t, err, "async needs a function call")
+	_, err = ParseSrc("a = 2sx")
+	assert.EqualError(t, err, "identifier starts immediately after numeric literal")
+}
+
+func TestParseSrc_With(t *testing.T) {
+	stmt, err := ParseSrc("with timeout(500ms) { a() }\nwith cancel(c) { }\nwith = with(timeout)")
+	assert.NoError(t, err)
+	stmts := stmt.(*ast.StmtsStmt).Stmts
+	timeout := stmts[0].(*ast.WithStmt)
+	assert.Equal(t, ast.WithTimeout, timeout.Kind)
+	assert.Equal(t, "500ms", timeout.Expr.(*ast.DurationExpr).Lit)
+	cancel := stmts[1].(*ast.WithStmt)
+	assert.Equal(t, ast.WithCancel, cancel.Kind)
+	assert.Equal(t, "c", cancel.Expr.(*ast.IdentExpr).Lit)
+	// with is only a keyword at the start of a with statement
+	assert.IsType(t, &ast.LetsStmt{}, stmts[2])
+}
+
+func TestParseSrc_Enum(t *testing.T) {
+	stmt, err := ParseSrc("enum Color {\n\tRed,\n\tGreen,\n}\nenum = Color.Red")
+	assert.NoError(t, err)
+	stmts := stmt.(*ast.StmtsStmt).Stmts
+	enum := stmts[0].(*ast.EnumStmt)
+	assert.Equal(t, "Color", enum.Name)
+	assert.Equal(t, []string{"Red", "Green"}, enum.Names)
+	// enum is only a keyword at the start of an enum statement
+	assert.IsType(t, &ast.LetsStmt{}, stmts[1])
+
+	_, err = ParseSrc("enum Color { Red, Red }")
+	assert.EqualError(t, err, "enum Color declares Red twice")
+}
+
+func TestParseSrc_Generator(t *testing.T) {
+	stmt, err := ParseSrc("func g() { if a { yield 1 } }\nfunc f() { return func() { yield 1 } }")
+	assert.NoError(t, err)
+	stmts := stmt.(*ast.StmtsStmt).Stmts
+	assert.True(t, stmts[0].(*ast.ExprStmt).Expr.(*ast.FuncExpr).Generator)
+	f := stmts[1].(*ast.ExprStmt).Expr.(*ast.FuncExpr)
+	assert.False(t, f.Generator)
+	inner := f.Stmt.(*ast.StmtsStmt).Stmts[0].(*ast.ReturnStmt).Exprs.(*ast.ExprsExpr).Exprs[0].(*ast.FuncExpr)
+	assert.True(t, inner.Generator)
+
+	// yield is only a keyword at the start of a statement, followed by the value yielded
+	stmt, err = ParseSrc("yield = [1]\nyield[0] = yield(2)\nfunc g() { yield [yield] }")
+	assert.NoError(t, err)
+	stmts = stmt.(*ast.StmtsStmt).Stmts
+	assert.IsType(t, &ast.LetsStmt{}, stmts[0])
+	assert.IsType(t, &ast.LetsStmt{}, stmts[1])
+	g := stmts[2].(*ast.ExprStmt).Expr.(*ast.FuncExpr)
+	assert.True(t, g.Generator)
+	assert.IsType(t, &ast.ArrayExpr{}, g.Stmt.(*ast.StmtsStmt).Stmts[0].(*ast.YieldStmt).Expr)
+}
+
+func TestParseSrc_UnknownCharacter(t *testing.T) {
+	// a character unknown to the grammar is named by itself in the syntax error
+	for _, src := range []string{"@", "a = 1; @", "a @ b"} {
+		_, err := ParseSrc(src)
+		assert.EqualError(t, err, "unexpected '@'", src)
+	}
+}
@@ -0,0 +1,60 @@
+package parser
+
+import (
+	"github.com/alaingilbert/anko/pkg/ast"
+)
+
+// checkPattern reports an error if a list or a map literal used as a destructuring pattern is not a valid one.
+// The values of a pattern are the targets of the destructuring, or nested patterns, and a list pattern can end with
+// the rest of its values, ...rest, or a map pattern with the rest of its entries. The targets of the patterns declaring variables must be identifiers.
+func checkPattern(yylex yyLexer, expr ast.Expr, declare bool) {
+	if !isPattern(expr, declare) {
+		yylex.Error("syntax error: invalid destructuring pattern")
+	}
+}
+
+func isPattern(expr ast.Expr, declare bool) bool {
+	switch e := expr.(type) {
+	case *ast.ArrayExpr:
+		if e.TypeData != nil {
+			return false
+		}
+		for i, el := range e.Exprs.Exprs {
+			if spread, ok := el.(*ast.SpreadExpr); ok {
+				if i != len(e.Exprs.Exprs)-1 || !isPatternTarget(spread.Expr, declare) {
+					return false
+				}
+			} else if !isPatternTarget(el, declare) {
+				return false
+			}
+		}
+		return true
+	case *ast.MapExpr:
+		if e.TypeData != nil {
+			return false
+		}
+		for i, key := range e.Keys.Exprs {
+			if spread, ok := key.(*ast.SpreadExpr); ok {
+				if i != len(e.Keys.Exprs)-1 || !isPatternTarget(spread.Expr, declare) {
+					return false
+				}
+			} else if !isPatternTarget(e.Values.Exprs[i], declare) {
+				return false
+			}
+		}
+		return true
+	}
+	return true // not a pattern
+}
+
+func isPatternTarget(expr ast.Expr, declare bool) bool {
+	switch expr.(type) {
+	case *ast.ArrayExpr, *ast.MapExpr:
+		return isPattern(expr, declare)
+	case *ast.IdentExpr:
+		return true
+	case *ast.SpreadExpr:
+		return false
+	}
+	return !declare
+}
@@ -0,0 +1,19 @@
+package parser
+
+import (
+	"github.com/alaingilbert/anko/pkg/ast"
+)
+
+// appendStmts appends the statements parsed after a syntax error at the top level to the ones parsed before it
+func appendStmts(stmt, next ast.Stmt) ast.Stmt {
+	stmts, _ := stmt.(*ast.StmtsStmt)
+	nextStmts, _ := next.(*ast.StmtsStmt)
+	if stmts == nil {
+		stmts = &ast.StmtsStmt{}
+	}
+	if nextStmts != nil {
+		stmts.Stmts = append(stmts.Stmts, nextStmts.Stmts...)
+		setSpan(stmts, nextStmts.Span())
+	}
+	return stmts
+}
@@ -0,0 +1,84 @@
+package parser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSrcRecover(t *testing.T) {
+	type parseErr struct {
+		Line, Column int
+		Message      string
+		Fatal        bool
+	}
+	tests := []struct {
+		name  string
+		src   string
+		lines []int // lines of the statements parsed
+		errs  []parseErr
+	}{
+		{name: "valid", src: "a = 1\nb = 2", lines: []int{1, 2}},
+		{name: "statements", src: "a = 1\nb = ]\nc = 3\nd = )\ne = 5\n", lines: []int{1, 3, 5},
+			errs: []parseErr{{2, 5, "unexpected ']'", false}, {4, 5, "unexpected ')'", false}}},
+		{name: "first and last lines", src: "b = ]\nc = 3\nd = )", lines: []int{2},
+			errs: []parseErr{{1, 5, "unexpected ']'", false}, {3, 5, "unexpected ')'", false}}},
+		{name: "semicolons", src: "a = 1; b = ]; c = 2\nd = 3", lines: []int{1, 1, 2},
+			errs: []parseErr{{1, 12, "unexpected ']'", false}}},
+		{name: "block", src: "if a {\n b = ]\n c = 2\n}\nd = (\ne = 3\n", lines: []int{1, 6},
+			errs: []parseErr{{2, 6, "unexpected ']'", false}, {5, 6, "unexpected '\\n'", false}}},
+		{name: "end of block", src: "if a { b = 1 @ 3 }\nc = ]\nd = 1", lines: []int{1, 3},
+			errs: []parseErr{{1, 14, "syntax error on '@' at 1:14", true}, {2, 5, "unexpected ']'", false}}},
+		{name: "scanner", src: "a = 1 @ 2\nb = 2\nc = \"x", lines: []int{1, 2, 3},
+			errs: []parseErr{{1, 7, "syntax error on '@' at 1:7", true}, {3, 5, "unexpected EOF", true}}},
+		{name: "rule", src: "a = 1, 2, 3\nb = ]\nc = 1", lines: []int{1, 3},
+			errs: []parseErr{{1, 12, "unexpected ','", false}, {2, 5, "unexpected ']'", false}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stmt, errs := ParseSrcRecover(tt.src)
+			var lines []int
+			if stmts, ok := stmt.(*ast.StmtsStmt); ok {
+				for _, s := range stmts.Stmts {
+					lines = append(lines, s.Position().Line)
+				}
+			}
+			assert.Equal(t, tt.lines, lines)
+			var got []parseErr
+			for _, e := range errs {
+				got = append(got, parseErr{e.Pos.Line, e.Pos.Column, e.Message, e.Fatal})
+			}
+			assert.Equal(t, tt.errs, got)
+		})
+	}
+}
+
+func TestParseSrcRecover_MaxErrors(t *testing.T) {
+	src := ""
+	for i := 0; i < 20; i++ {
+		src += "a = ]\n"
+	}
+	_, errs := ParseSrcRecover(src)
+	assert.Len(t, errs, maxErrors)
+}
+
+func TestParse_StopsAtFirstError(t *testing.T) {
+	stmt, err := ParseSrc("a = 1\nb = ]\nc = )")
+	assert.Nil(t, stmt)
+	var pe *Error
+	assert.True(t, errors.As(err, &pe))
+	assert.Equal(t, ast.Position{Line: 2, Column: 5}, pe.Pos)
+}
+
+func TestErrorList(t *testing.T) {
+	assert.Nil(t, ErrorList(nil).Err())
+	list := ErrorList{{Message: "first"}, {Message: "second"}, {Message: "third"}}
+	assert.Equal(t, "first (and 2 more errors)", list.Err().Error())
+	assert.Equal(t, "first", list[:1].Error())
+	var pe *Error
+	assert.True(t, errors.As(error(list), &pe))
+	assert.Equal(t, "first", pe.Message)
+	assert.True(t, errors.Is(list, list[1]))
+}
@@ -0,0 +1,35 @@
+package parser
+
+import (
+	"reflect"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+)
+
+// spanOf returns the span of the node, an empty span if the node is nil
+func spanOf(node ast.Pos) ast.Span {
+	if isNil(node) {
+		return ast.Span{}
+	}
+	return node.Span()
+}
+
+// setSpan sets the span of the node built by a grammar rule to the range covering the spans of its symbols
+func setSpan(node ast.Pos, spans ...ast.Span) {
+	if isNil(node) {
+		return
+	}
+	var span ast.Span
+	for _, s := range spans {
+		span = span.Merge(s)
+	}
+	node.SetSpan(node.Span().Merge(span))
+}
+
+func isNil(node ast.Pos) bool {
+	if node == nil {
+		return true
+	}
+	v := reflect.ValueOf(node)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
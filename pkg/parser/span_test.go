@@ -0,0 +1,71 @@
+package parser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFile_Spans(t *testing.T) {
+	src := "a = foo(1, 2) + -b\nif x {\n    y++\n}"
+	stmt, err := ParseFile("f.ank", src)
+	assert.NoError(t, err)
+	text := func(node ast.Pos) string {
+		span := node.Span()
+		assert.Equal(t, "f.ank", span.File)
+		return string([]rune(src)[span.StartOffset:span.EndOffset])
+	}
+	stmts := stmt.(*ast.StmtsStmt).Stmts
+	assign := stmts[0].(*ast.LetsStmt)
+	assert.Equal(t, "a = foo(1, 2) + -b", text(assign))
+	binOp := assign.Rhss.(*ast.ExprsExpr).Exprs[0].(*ast.BinOpExpr)
+	assert.Equal(t, "foo(1, 2) + -b", text(binOp))
+	assert.Equal(t, "foo(1, 2)", text(binOp.Lhs))
+	assert.Equal(t, "-b", text(binOp.Rhs))
+	assert.Equal(t, ast.Position{Line: 1, Column: 5}, binOp.Span().Start)
+	assert.Equal(t, ast.Position{Line: 1, Column: 19}, binOp.Span().End)
+
+	ifStmt := stmts[1].(*ast.IfStmt)
+	assert.Equal(t, "if x {\n    y++\n}", text(ifStmt))
+	assert.Equal(t, "x", text(ifStmt.If))
+	assert.Equal(t, ast.Position{Line: 4, Column: 2}, ifStmt.Span().End)
+}
+
+func TestParseFile_Error(t *testing.T) {
+	_, err := ParseFile("f.ank", "a = 1\nb = ]")
+	var pe *Error
+	assert.True(t, errors.As(err, &pe))
+	assert.Equal(t, "f.ank", pe.Filename)
+	assert.Equal(t, "f.ank", pe.Span.File)
+	assert.Equal(t, ast.Position{Line: 2, Column: 5}, pe.Span.Start)
+	assert.Equal(t, ast.Position{Line: 2, Column: 6}, pe.Span.End)
+
+	_, err = ParseSrc("b = ]")
+	assert.True(t, errors.As(err, &pe))
+	assert.Equal(t, "", pe.Filename)
+	assert.Equal(t, "", pe.Span.File)
+}
+
+func TestParseFile_InterpolationSpans(t *testing.T) {
+	src := "x = 1\ns = \"a ${foo(x)} b ${y}\""
+	stmt, err := ParseFile("f.ank", src)
+	assert.NoError(t, err)
+	text := func(node ast.Pos) string {
+		span := node.Span()
+		assert.Equal(t, "f.ank", span.File)
+		return string([]rune(src)[span.StartOffset:span.EndOffset])
+	}
+	assign := stmt.(*ast.StmtsStmt).Stmts[1].(*ast.LetsStmt)
+	interp := assign.Rhss.(*ast.ExprsExpr).Exprs[0].(*ast.InterpExpr)
+	assert.Equal(t, `"a ${foo(x)} b ${y}"`, text(interp))
+	assert.Equal(t, []string{"a ", " b ", ""}, interp.Strs)
+	assert.Equal(t, "foo(x)", text(interp.Exprs[0]))
+	assert.Equal(t, ast.Position{Line: 2, Column: 22}, interp.Exprs[1].Span().Start)
+
+	_, err = ParseFile("f.ank", "s = \"a ${1 +} b\"")
+	var pe *Error
+	assert.True(t, errors.As(err, &pe))
+	assert.Equal(t, ast.Position{Line: 1, Column: 13}, pe.Pos)
+}
@@ -0,0 +1,19 @@
+package parser
+
+import (
+	"github.com/alaingilbert/anko/pkg/ast"
+)
+
+// clauseStmt returns the statements of a block or of a case or default clause, tok is its first token.
+// An empty block or clause gives an empty list of statements at the position of tok, instead of nil:
+// a switch or a select with an empty default clause is not the same as one without a default clause,
+// and the comments written in an empty block or clause are attached to it.
+func clauseStmt(tok ast.Token, stmt ast.Stmt) ast.Stmt {
+	if stmt != nil {
+		return stmt
+	}
+	stmts := &ast.StmtsStmt{}
+	stmts.SetPosition(tok.Position())
+	setSpan(stmts, tok.Span())
+	return stmts
+}
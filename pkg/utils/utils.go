@@ -5,6 +5,7 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"strconv"
+	"strings"
 )
 
 func First[T any](a T, _ ...any) T { return a }
@@ -29,6 +30,11 @@ func MD5(in []byte) string {
 	return hex.EncodeToString(h.Sum(nil))
 }
 
+// IsModulePath returns either or not the source given to import is the path of a script module
+func IsModulePath(source string) bool {
+	return strings.HasSuffix(source, ".ank") || strings.HasSuffix(source, ".bnk")
+}
+
 func Must[T any](v T, err error) T {
 	if err != nil {
 		panic(err)
@@ -12,6 +12,8 @@ import (
 	"strconv"
 	"strings"
 	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
 )
 
 // It's fine for this map to not be mutex protected, so long as no one writes in it
@@ -53,6 +55,7 @@ type IEnv interface {
 	Define(k string, v any) error
 	DefineCtx(k string, v any) error
 	DefineGlobalValue(k string, v reflect.Value) error
+	DefineMethod(t reflect.Type, name string, fn reflect.Value) error
 	DefineReflectType(k string, t reflect.Type) error
 	DefineType(k string, t any) error
 	DefineValue(k string, v reflect.Value) error
@@ -61,12 +64,16 @@ type IEnv interface {
 	Destroy()
 	Get(k string) (any, error)
 	GetEnvFromPath(path []string) (IEnv, error)
+	GetMethod(t reflect.Type, name string) (reflect.Value, bool)
 	GetValue(k string) (reflect.Value, error)
 	HasValue(k string) bool
 	Name() string
 	NewEnv() IEnv
 	WithNewEnv(func(IEnv))
 	NewModule(symbol string) (IEnv, error)
+	NewModuleEnv() IEnv
+	Export(k string)
+	IsVisible(k string) bool
 	SetValue(k string, v reflect.Value) error
 	String() string
 	Type(k string) (reflect.Type, error)
@@ -84,7 +91,15 @@ type Env struct {
 	name          *mtx.Mtx[string]
 	values        *mtx.Map[string, reflect.Value]
 	types         *mtx.Map[string, reflect.Type]
+	methods       *mtx.Map[methodKey, reflect.Value]
 	defers        *mtx.Slice[CapturedFunc]
+	exports       *mtx.Map[string, struct{}] // symbols exported by a script module, nil if the env is not one
+}
+
+// methodKey identifies a method declared by a script on one of its types
+type methodKey struct {
+	typ  reflect.Type
+	name string
 }
 
 // NewEnv creates new global scope.
@@ -99,6 +114,17 @@ func (e *Env) WithNewEnv(clb func(IEnv)) { e.withNewEnv(clb) }
 // This is a shortcut for calling e.NewEnv then Define that new Env.
 func (e *Env) NewModule(symbol string) (IEnv, error) { return e.newModule(symbol) }
 
+// NewModuleEnv creates new child scope for a script module.
+// Its symbols are private, unless they are capitalized or exported.
+func (e *Env) NewModuleEnv() IEnv { return e.newModuleEnv() }
+
+// Export makes a symbol of a script module visible from outside of it. It does nothing in other scopes.
+func (e *Env) Export(k string) { e.export(k) }
+
+// IsVisible returns either or not the symbol can be accessed from outside of the scope, as a member of it.
+// All the symbols are visible, except the private ones of a script module.
+func (e *Env) IsVisible(k string) bool { return e.isVisible(k) }
+
 func (e *Env) Destroy() { e.destroy() }
 
 func (e *Env) ChildCount() int64 { return e.childCount() }
@@ -179,6 +205,17 @@ func (e *Env) DefineType(k string, t any) error { return e.defineType(k, t) }
 // DefineReflectType defines type in current scope.
 func (e *Env) DefineReflectType(k string, t reflect.Type) error { return e.defineReflectType(k, t) }
 
+// DefineMethod defines in current scope a method of a type declared by a script.
+// The function takes the receiver as first argument.
+func (e *Env) DefineMethod(t reflect.Type, name string, fn reflect.Value) error {
+	return e.defineMethod(t, name, fn)
+}
+
+// GetMethod returns the method of a type declared by a script. It goes to upper scope until found.
+func (e *Env) GetMethod(t reflect.Type, name string) (reflect.Value, bool) {
+	return e.getMethod(t, name)
+}
+
 // Copy the state of the virtual machine environment
 //func (e *Env) Copy() *Env { return e.copy() }
 
@@ -240,11 +277,12 @@ func (e *UndefinedSymbolErr) Error() string {
 
 func newEnv() *Env {
 	return &Env{
-		parent: nil,
-		name:   mtx.NewRWMtxPtr(""),
-		values: mtx.NewRWMapPtr(map[string]reflect.Value{}),
-		types:  mtx.NewRWMapPtr(map[string]reflect.Type{}),
-		defers: mtx.NewRWSlicePtr([]CapturedFunc{}),
+		parent:  nil,
+		name:    mtx.NewRWMtxPtr(""),
+		values:  mtx.NewRWMapPtr(map[string]reflect.Value{}),
+		types:   mtx.NewRWMapPtr(map[string]reflect.Type{}),
+		methods: mtx.NewRWMapPtr(map[methodKey]reflect.Value{}),
+		defers:  mtx.NewRWSlicePtr([]CapturedFunc{}),
 	}
 }
 
@@ -255,6 +293,31 @@ func (e *Env) newEnv() *Env {
 	return env
 }
 
+func (e *Env) newModuleEnv() *Env {
+	env := e.newEnv()
+	env.exports = mtx.NewRWMapPtr(map[string]struct{}{})
+	return env
+}
+
+func (e *Env) export(k string) {
+	if e.exports != nil {
+		e.exports.Insert(k, struct{}{})
+	}
+}
+
+func (e *Env) isVisible(k string) bool {
+	if e.exports == nil || isExportedName(k) {
+		return true
+	}
+	return e.exports.ContainsKey(k)
+}
+
+// isExportedName returns either or not the name starts with an upper case letter
+func isExportedName(name string) bool {
+	r, _ := utf8.DecodeRuneInString(name)
+	return unicode.IsUpper(r)
+}
+
 func (e *Env) destroy() {
 	e.incrChildCount(-1)
 }
@@ -586,6 +649,24 @@ func (e *Env) defineReflectType(k string, t reflect.Type) error {
 	return nil
 }
 
+func (e *Env) defineMethod(t reflect.Type, name string, fn reflect.Value) error {
+	if err := validateSymbolName(name); err != nil {
+		return err
+	}
+	e.methods.Insert(methodKey{typ: t, name: name}, fn)
+	return nil
+}
+
+func (e *Env) getMethod(t reflect.Type, name string) (reflect.Value, bool) {
+	if fn, ok := e.methods.Get(methodKey{typ: t, name: name}); ok {
+		return fn, true
+	}
+	if e.parent == nil {
+		return nilValue, false
+	}
+	return e.parent.getMethod(t, name)
+}
+
 func (e *Env) copy() *Env {
 	copyEnv := newEnv()
 	copyEnv.parent = e.parent
@@ -593,6 +674,10 @@ func (e *Env) copy() *Env {
 	if e.types != nil {
 		copyEnv.types.Store(e.types.Clone())
 	}
+	copyEnv.methods.Store(e.methods.Clone())
+	if e.exports != nil {
+		copyEnv.exports = mtx.NewRWMapPtr(e.exports.Clone())
+	}
 	return copyEnv
 }
 
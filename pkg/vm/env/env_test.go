@@ -1276,6 +1276,31 @@ func TestEnv_NewModule(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestEnv_NewModuleEnv(t *testing.T) {
+	env := NewEnv()
+	_ = env.Define("a", 1)
+	module := env.NewModuleEnv()
+	_ = module.Define("b", 2)
+	_ = module.Define("C", 3)
+	_ = module.Define("é", 4)
+	_ = module.Define("É", 5)
+	assert.True(t, env.IsVisible("a"))
+	assert.False(t, module.IsVisible("b"))
+	assert.True(t, module.IsVisible("C"))
+	assert.False(t, module.IsVisible("é"))
+	assert.True(t, module.IsVisible("É"))
+	module.Export("b")
+	assert.True(t, module.IsVisible("b"))
+	assert.True(t, module.DeepCopy().IsVisible("b"))
+	assert.False(t, module.DeepCopy().IsVisible("é"))
+
+	// Exporting a symbol does nothing outside of a module
+	child := env.NewEnv()
+	child.Export("x")
+	assert.True(t, child.IsVisible("x"))
+	assert.Equal(t, int64(2), env.ChildCount())
+}
+
 func TestEnv_GetEnvFromPath(t *testing.T) {
 	env := NewEnv()
 	_, err := env.GetEnvFromPath([]string{})
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/alaingilbert/anko/pkg/ast"
+	"github.com/alaingilbert/anko/pkg/ast/optimize"
 	"github.com/alaingilbert/anko/pkg/compiler"
 	"github.com/alaingilbert/anko/pkg/parser"
 	"github.com/alaingilbert/anko/pkg/utils"
@@ -16,6 +17,7 @@ import (
 	vmUtils "github.com/alaingilbert/anko/pkg/vm/utils"
 	"github.com/alaingilbert/mtx"
 	"os"
+	"path/filepath"
 	"reflect"
 	"sync/atomic"
 	"time"
@@ -39,6 +41,7 @@ type IExecutor interface {
 	Subscribe() *Sub
 	TogglePause() TogglePauseResult
 	Validate(ctx context.Context, input any) error
+	ValidateWarnings(ctx context.Context, input any) ([]error, error)
 
 	GetEnv() envPkg.IEnv
 }
@@ -90,6 +93,10 @@ type Executor struct {
 	pubSubEvts       *pubsub.PubSub[string, Evt]          // pubsub for executor's events
 	dbgEnabled       bool                                 // either or not to enable dbg()
 	resetEnv         bool                                 // either or not to reset the env before each run
+	optimize         bool                                 // either or not to optimize the AST before running it
+	bundle           *compiler.Bundle                     // bundle being run, load() looks in it before the filesystem
+	importPaths      []string                             // directories searched for the imported script modules
+	modules          *moduleCache                         // script modules imported by the scripts, by path
 }
 
 // Config for the executor
@@ -105,6 +112,8 @@ type Config struct {
 	RateLimitPeriod *time.Duration
 	Env             envPkg.IEnv
 	MaxEnvCount     *int
+	Optimize        *bool
+	ImportPaths     []string // directories searched for the script modules imported with a path not starting with ./ or ../
 }
 
 // NewExecutor creates a new executor
@@ -138,12 +147,15 @@ func NewExecutor(cfg *Config) *Executor {
 	e.importCore = utils.Default(cfg.ImportCore, false)
 	e.dbgEnabled = utils.Default(cfg.DbgEnabled, true)
 	e.resetEnv = utils.Default(cfg.ResetEnv, false)
+	e.optimize = utils.Default(cfg.Optimize, false)
 	e.doNotProtectMaps = utils.Default(cfg.ProtectMaps, true)
 	e.mapMutex = &runner.MapLocker{}
 	e.watchdogEnabled = utils.Default(cfg.Watchdog, true)
 	e.maxEnvCount = mtx.NewRWMtxPtr(int64(maxEnvCount))
 	e.rateLimit = ratelimitanything.NewRateLimitAnything(int64(rateLimit), period)
 	e.pubSubEvts = pubsub.NewPubSub[Evt](nil)
+	e.importPaths = cfg.ImportPaths
+	e.modules = newModuleCache()
 	return e
 }
 
@@ -167,6 +179,12 @@ func (e *Executor) Validate(ctx context.Context, input any) error {
 	return e.validate(ctx, input)
 }
 
+// ValidateWarnings validates the input like Validate, and returns the issues which do not stop the script from running,
+// ex: a runner.NonExhaustiveSwitchError. When the script has an error, only the error is returned.
+func (e *Executor) ValidateWarnings(ctx context.Context, input any) ([]error, error) {
+	return e.validateWarnings(ctx, input)
+}
+
 // Has receives targets, and return either or not these targets are being used in the script.
 // It does not actually run the script, it only statically check if the targets are used or not.
 func (e *Executor) Has(ctx context.Context, input any, targets []any) ([]bool, error) {
@@ -245,11 +263,24 @@ func (e *Executor) validate(ctx context.Context, input any) error {
 		return e.ValidateWithContext(ctx, vv)
 	case []byte:
 		return e.ValidateCompiledWithContext(ctx, vv)
+	case ast.Stmt:
+		return e.mainRunValidate(ctx, vv)
 	default:
 		return ErrInvalidInput
 	}
 }
 
+// warningsKey is the key of the warnings collected by ValidateWarnings, in the context of a script
+type warningsKey struct{}
+
+func (e *Executor) validateWarnings(ctx context.Context, input any) ([]error, error) {
+	var warnings []error
+	if err := e.validate(context.WithValue(ctx, warningsKey{}, &warnings), input); err != nil {
+		return nil, err
+	}
+	return warnings, nil
+}
+
 func (e *Executor) has(ctx context.Context, input any, targets []any) ([]bool, error) {
 	switch vv := input.(type) {
 	case string:
@@ -339,8 +370,26 @@ func srcToStmt(src string) (ast.Stmt, error) {
 	return parser.ParseSrc(src)
 }
 
-func decode(by []byte) ast.Stmt {
-	return compiler.Decode(by)
+func decode(by []byte) (ast.Stmt, error) {
+	return compiler.DecodeSafe(by)
+}
+
+// decodeCompiled decodes either a compiled script or a bundle, in which case the main module is returned along with the bundle
+func decodeCompiled(by []byte) (ast.Stmt, *compiler.Bundle, error) {
+	if !compiler.IsBundle(by) {
+		stmt, err := decode(by)
+		return stmt, nil, err
+	}
+	bundle, err := compiler.DecodeBundle(by)
+	if err != nil {
+		return nil, nil, err
+	}
+	main, _ := bundle.Get(bundle.Main)
+	stmt, err := decode(main)
+	if err != nil {
+		return nil, nil, err
+	}
+	return stmt, bundle, nil
 }
 
 func (e *Executor) executeWithContext(ctx context.Context, src string) (any, error) {
@@ -351,10 +400,11 @@ func (e *Executor) executeWithContext(ctx context.Context, src string) (any, err
 	return e.runWithContext(ctx, stmt)
 }
 
+// ValidateWithContext validates the script. Every syntax error of the script is returned at once, as a parser.ErrorList.
 func (e *Executor) ValidateWithContext(ctx context.Context, src string) error {
-	stmt, err := srcToStmt(src)
-	if err != nil {
-		return err
+	stmt, errs := parser.ParseSrcRecover(src)
+	if len(errs) > 0 {
+		return errs
 	}
 	return e.mainRunValidate(ctx, stmt)
 }
@@ -368,23 +418,46 @@ func (e *Executor) HasWithContext(ctx context.Context, src string, targets []any
 }
 
 func (e *Executor) executeCompiledWithContext(ctx context.Context, src []byte) (any, error) {
-	return e.runWithContext(ctx, decode(src))
+	stmt, bundle, err := decodeCompiled(src)
+	if err != nil {
+		return nil, err
+	}
+	e.bundle = bundle
+	defer func() { e.bundle = nil }()
+	return e.runWithContext(ctx, stmt)
 }
 
 func (e *Executor) ValidateCompiledWithContext(ctx context.Context, src []byte) error {
-	return e.mainRunValidate(ctx, decode(src))
+	stmt, _, err := decodeCompiled(src)
+	if err != nil {
+		return err
+	}
+	return e.mainRunValidate(ctx, stmt)
 }
 
 func (e *Executor) HasCompiledWithContext(ctx context.Context, src []byte, targets []any) ([]bool, error) {
-	return e.hasAST(ctx, decode(src), targets)
+	stmt, _, err := decodeCompiled(src)
+	if err != nil {
+		return nil, err
+	}
+	return e.hasAST(ctx, stmt, targets)
 }
 
 func (e *Executor) runWithContext(ctx context.Context, stmts ast.Stmt) (any, error) {
-	return valueToAny(e.mainRunNoTargets(ctx, stmts, false))
+	return valueToAny(e.mainRunNoTargets(ctx, e.optimizeStmt(stmts), false))
 }
 
-func (e *Executor) runWithContextForLoad(ctx context.Context, stmts ast.Stmt) (any, error) {
-	return valueToAny(e.mainRunForLoad(ctx, stmts))
+func (e *Executor) runWithContextForLoad(ctx context.Context, stmts ast.Stmt, fileName string) (any, error) {
+	return valueToAny(e.mainRunForLoad(ctx, e.optimizeStmt(stmts), fileName))
+}
+
+// optimizeStmt optimizes the AST if the executor is configured to do so.
+// Validate and Has are never optimized, since they need to see the whole script.
+func (e *Executor) optimizeStmt(stmt ast.Stmt) ast.Stmt {
+	if !e.optimize || stmt == nil {
+		return stmt
+	}
+	return optimize.Optimize(stmt)
 }
 
 func valueToAny(rv reflect.Value, err error) (any, error) {
@@ -410,8 +483,8 @@ func (e *Executor) mainRunNoTargets(ctx context.Context, stmt ast.Stmt, validate
 	return rv, err
 }
 
-func (e *Executor) mainRunForLoad(ctx context.Context, stmt ast.Stmt) (reflect.Value, error) {
-	_, rv, err := e.mainRun(ctx, stmt, e.env, false, nil)
+func (e *Executor) mainRunForLoad(ctx context.Context, stmt ast.Stmt, fileName string) (reflect.Value, error) {
+	_, rv, err := e.mainRun(ctx, stmt, e.env, false, nil, e.importer(e.modules, e.env, fileName, e.bundle, false))
 	return rv, err
 }
 
@@ -430,28 +503,23 @@ func (e *Executor) watchdog(ctx context.Context, cancel context.CancelCauseFunc,
 	}
 }
 
-// Dynamically load a file and execute it, return the RV value
+// Dynamically load a file and execute it, return the RV value.
+// If a bundle is being run, the file is looked up in the bundle before the filesystem.
 func (e *Executor) loadFn(ctx context.Context, validate bool) func(string) any {
 	return func(s string) any {
 		if validate {
 			return nilValue
 		}
-		body, err := os.ReadFile(s)
-		if err != nil {
-			panic(err)
-		}
-		scanner := new(parser.Scanner)
-		scanner.Init(string(body))
-		stmts, err := parser.Parse(scanner)
-		if err != nil {
-			var pe *parser.Error
-			if errors.As(err, &pe) {
-				pe.Filename = s
-				panic(pe)
+		var stmts ast.Stmt
+		if code, ok := e.bundledFile(s); ok {
+			var err error
+			if stmts, err = decode(code); err != nil {
+				panic(err)
 			}
-			panic(err)
+		} else {
+			stmts = parseFile(s)
 		}
-		rv, err := e.runWithContextForLoad(ctx, stmts)
+		rv, err := e.runWithContextForLoad(ctx, stmts, s)
 		if err != nil {
 			panic(err)
 		}
@@ -459,6 +527,26 @@ func (e *Executor) loadFn(ctx context.Context, validate bool) func(string) any {
 	}
 }
 
+func (e *Executor) bundledFile(fileName string) ([]byte, bool) {
+	if e.bundle == nil {
+		return nil, false
+	}
+	return e.bundle.Get(fileName)
+}
+
+// parseFile reads and parses a script, panics on error
+func parseFile(fileName string) ast.Stmt {
+	body, err := os.ReadFile(fileName)
+	if err != nil {
+		panic(err)
+	}
+	stmts, err := parser.ParseFile(fileName, string(body))
+	if err != nil {
+		panic(err)
+	}
+	return stmts
+}
+
 func (e *Executor) mainRunWithWatchdog(ctx context.Context, stmt ast.Stmt, validate bool, targets []any) ([]bool, reflect.Value, error) {
 	if e.importCore {
 		_ = e.env.Define("load", e.loadFn(ctx, validate))
@@ -476,14 +564,35 @@ func (e *Executor) mainRunWithWatchdog(ctx context.Context, stmt ast.Stmt, valid
 		go e.watchdog(ctx, cancel, newEnv)
 	}
 
-	return e.mainRun(ctx, stmt, newEnv, validate, targets)
+	// The modules imported by a validation, or with an env which is reset, are not kept
+	modules := e.modules
+	if validate || e.resetEnv {
+		modules = newModuleCache()
+	}
+	path := scriptPath(stmt)
+	if e.bundle != nil {
+		path = e.bundle.Main
+	}
+	if path != "" {
+		// the script cannot be imported by the modules it imports
+		ctx = context.WithValue(ctx, importChainKey{}, []*moduleImport{{path: filepath.Clean(path)}})
+	}
+	return e.mainRun(ctx, stmt, newEnv, validate, targets, e.importer(modules, newEnv, path, e.bundle, validate))
+}
+
+// scriptPath returns the path of the file the script was parsed from, empty if it is not known
+func scriptPath(stmt ast.Stmt) string {
+	if stmt == nil {
+		return ""
+	}
+	return stmt.Span().File
 }
 
 var nilValue = vmUtils.NilValue
 var ErrInvalidInput = errors.New("invalid input")
 var ErrAlreadyRunning = errors.New("executor already running")
 
-func (e *Executor) mainRun(ctx context.Context, stmt ast.Stmt, env envPkg.IEnv, validate bool, targets []any) ([]bool, reflect.Value, error) {
+func (e *Executor) mainRun(ctx context.Context, stmt ast.Stmt, env envPkg.IEnv, validate bool, targets []any, importFn runner.ImportFunc) ([]bool, reflect.Value, error) {
 	stmt1, ok := stmt.(*ast.StmtsStmt)
 	if !ok || stmt1 == nil {
 		return nil, nilValue, ErrInvalidInput
@@ -494,6 +603,9 @@ func (e *Executor) mainRun(ctx context.Context, stmt ast.Stmt, env envPkg.IEnv,
 		has[fmt.Sprintf("%v", vv)] = false
 	}
 
+	// the warnings are only collected for ValidateWarnings, the ones of the imported modules included
+	warnings, _ := ctx.Value(warningsKey{}).(*[]error)
+
 	rv, err := runner.Run(&runner.Config{
 		Ctx:         ctx,
 		Env:         env,
@@ -506,6 +618,8 @@ func (e *Executor) mainRun(ctx context.Context, stmt ast.Stmt, env envPkg.IEnv,
 		DbgEnabled:  e.dbgEnabled,
 		Validate:    validate,
 		Has:         has,
+		Import:      importFn,
+		Warnings:    warnings,
 	})
 	if errors.Is(err, runner.ErrReturn) {
 		err = nil
@@ -3,10 +3,16 @@ package executor
 import (
 	"context"
 	"github.com/alaingilbert/anko/pkg/ast"
+	"github.com/alaingilbert/anko/pkg/ast/obfuscate"
 	"github.com/alaingilbert/anko/pkg/compiler"
 	"github.com/alaingilbert/anko/pkg/parser"
+	"github.com/alaingilbert/anko/pkg/utils"
 	envPkg "github.com/alaingilbert/anko/pkg/vm/env"
+	"github.com/alaingilbert/anko/pkg/vm/runner"
 	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -20,6 +26,16 @@ func TestGetCycles(t *testing.T) {
 	assert.Equal(t, int64(11), e.getCycles())
 }
 
+func TestGetCycles_Comprehension(t *testing.T) {
+	// each value of a comprehension is counted like an iteration of a for-in loop
+	for script, cycles := range map[string]int64{"[x for x in []]": 4, "[x for x in [1, 2]]": 12} {
+		e := NewExecutor(&Config{Env: envPkg.NewEnv()})
+		_, err := e.Run(context.Background(), script)
+		assert.NoError(t, err)
+		assert.Equal(t, cycles, e.getCycles(), script)
+	}
+}
+
 func TestInvalidInput(t *testing.T) {
 	env := envPkg.NewEnv()
 	e := NewExecutor(&Config{Env: env})
@@ -45,7 +61,7 @@ func TestRunStmts(t *testing.T) {
 
 func TestRunCompiled(t *testing.T) {
 	script := "a = 1; b = 2; if a == b { return a; }; return b"
-	by, _ := compiler.Compile(script, false)
+	by, _ := compiler.Compile(script, nil)
 	env := envPkg.NewEnv()
 	e := NewExecutor(&Config{Env: env})
 	val, err := e.Run(context.Background(), by)
@@ -54,6 +70,143 @@ func TestRunCompiled(t *testing.T) {
 	assert.Equal(t, int64(11), e.getCycles())
 }
 
+func TestRunOptimized(t *testing.T) {
+	script := "a = 1 + 2 * 3; if 1 > 2 { return 0 }; return a; a = 2"
+	env := envPkg.NewEnv()
+	e := NewExecutor(&Config{Env: env})
+	val, err := e.Run(context.Background(), script)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), val)
+	assert.Equal(t, int64(13), e.getCycles())
+
+	e = NewExecutor(&Config{Env: env, Optimize: utils.Ptr(true)})
+	val, err = e.Run(context.Background(), script)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), val)
+	assert.Equal(t, int64(5), e.getCycles())
+
+	// The short-circuit operators give the same values with and without the optimization
+	for _, script := range []string{`"abc" || 5`, `"" && 5`, `"false" || 5`, `"0" && 5`} {
+		expected, err := NewExecutor(&Config{Env: env}).Run(context.Background(), script)
+		assert.NoError(t, err)
+		val, err = e.Run(context.Background(), script)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, val, script)
+	}
+}
+
+func TestRunObfuscated(t *testing.T) {
+	script := `
+module m { func Double(x) { return x * 2 } }
+func add(a, b) { return a + b }
+total = 0
+for i in [1, 2, 3] { total = add(total, m.Double(i)) }
+try { throw "err" } catch e { total += len(e.Error()) }
+total + offset`
+	by, err := compiler.Compile(script, &obfuscate.Config{})
+	assert.NoError(t, err)
+	metadata, err := compiler.ReadMetadata(by)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"offset"}, metadata.HostIdents)
+	env := envPkg.NewEnv()
+	_ = env.Define("offset", 100)
+	e := NewExecutor(&Config{Env: env, ImportCore: utils.Ptr(true)})
+	val, err := e.Run(context.Background(), by)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(115), val)
+	assert.True(t, e.GetEnv().HasValue("m"))
+	assert.False(t, e.GetEnv().HasValue("total"))
+
+	// A host identifier keeps its name even if a function parameter shadows it
+	by, err = compiler.Compile("func f(x) { return x + 1 }; f(x)", &obfuscate.Config{})
+	assert.NoError(t, err)
+	metadata, err = compiler.ReadMetadata(by)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"x"}, metadata.HostIdents)
+	env = envPkg.NewEnv()
+	_ = env.Define("x", 41)
+	e = NewExecutor(&Config{Env: env})
+	val, err = e.Run(context.Background(), by)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), val)
+}
+
+func TestRunBundle(t *testing.T) {
+	main, _ := compiler.Compile(`load("lib/x.ank"); a + 1`, nil)
+	lib, _ := compiler.Compile(`a = 41`, nil)
+	bundle := compiler.NewBundle("main.ank")
+	bundle.Add("main.ank", main)
+	bundle.Add("lib/x.ank", lib)
+	by, err := compiler.EncodeBundle(bundle)
+	assert.NoError(t, err)
+	e := NewExecutor(&Config{Env: envPkg.NewEnv(), ImportCore: utils.Ptr(true)})
+	val, err := e.Run(context.Background(), by)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), val)
+	assert.NoError(t, e.Validate(context.Background(), by))
+
+	// Files are not resolved against the bundle once it is done running
+	_, err = e.Run(context.Background(), `load("lib/x.ank")`)
+	assert.Error(t, err)
+}
+
+func TestLoadErrorFile(t *testing.T) {
+	dir := t.TempDir()
+	fileName := filepath.Join(dir, "lib.ank")
+	assert.NoError(t, os.WriteFile(fileName, []byte("a = 1\nb = a + c"), 0644))
+	e := NewExecutor(&Config{Env: envPkg.NewEnv(), ImportCore: utils.Ptr(true)})
+	_, err := e.Run(context.Background(), `load("`+fileName+`")`)
+	var vmErr *runner.Error
+	assert.ErrorAs(t, err, &vmErr)
+	assert.Equal(t, fileName, vmErr.Span.File)
+	assert.Equal(t, ast.Position{Line: 2, Column: 9}, vmErr.Span.Start)
+	assert.Equal(t, ast.Position{Line: 2, Column: 10}, vmErr.Span.End)
+
+	assert.NoError(t, os.WriteFile(fileName, []byte("a = 1\nb = ]"), 0644))
+	_, err = e.Run(context.Background(), `load("`+fileName+`")`)
+	var parserErr *parser.Error
+	assert.ErrorAs(t, err, &parserErr)
+	assert.Equal(t, fileName, parserErr.Filename)
+	assert.Equal(t, 2, parserErr.Pos.Line)
+}
+
+func TestErrorStack(t *testing.T) {
+	dir := t.TempDir()
+	libFile := filepath.Join(dir, "lib.ank")
+	assert.NoError(t, os.WriteFile(libFile, []byte("func inner(x) {\n  return x + y\n}\nfunc outer(x) {\n  return inner(x)\n}"), 0644))
+	script := `load("` + libFile + `")
+f = func() {
+  return outer(1)
+}
+f()`
+	stmt, err := parser.ParseFile("main.ank", script)
+	assert.NoError(t, err)
+	e := NewExecutor(&Config{Env: envPkg.NewEnv(), ImportCore: utils.Ptr(true)})
+	_, err = e.Run(context.Background(), stmt)
+	var vmErr *runner.Error
+	assert.ErrorAs(t, err, &vmErr)
+	frames := make([]string, 0)
+	for _, frame := range vmErr.Stack {
+		frames = append(frames, frame.String())
+	}
+	assert.Equal(t, []string{
+		"inner (" + libFile + ":2:14)",
+		"outer (" + libFile + ":5:10)",
+		"<anonymous> (main.ank:3:10)",
+		"<main> (main.ank:5:1)",
+	}, frames)
+	assert.Equal(t, "\tat "+strings.Join(frames, "\n\tat ")+"\n", vmErr.StackTrace())
+
+	// An error at the top level of the script has a single frame
+	_, err = e.Run(context.Background(), "a = 1\nb = a + c")
+	assert.ErrorAs(t, err, &vmErr)
+	assert.Equal(t, []runner.Frame{{Func: "<main>", Pos: ast.Position{Line: 2, Column: 9}, Span: vmErr.Span}}, vmErr.Stack)
+
+	// Caught errors do not leak their stack
+	_, err = e.Run(context.Background(), "func g() { throw \"err\" }\ntry { g() } catch { }\nh = 1")
+	assert.NoError(t, err)
+}
+
 func TestInvalidString(t *testing.T) {
 	script := "a ==== 1"
 	env := envPkg.NewEnv()
@@ -101,6 +254,59 @@ func TestExecutor_Validate(t *testing.T) {
 	}
 }
 
+func TestExecutor_ValidateSyntaxErrors(t *testing.T) {
+	e := NewExecutor(&Config{Env: envPkg.NewEnv()})
+	err := e.Validate(context.Background(), "a = 1\nb = ]\nc = 3\nd = )")
+	var errs parser.ErrorList
+	assert.ErrorAs(t, err, &errs)
+	assert.Len(t, errs, 2)
+	assert.Equal(t, "unexpected ']' (and 1 more errors)", err.Error())
+	var pe *parser.Error
+	assert.ErrorAs(t, err, &pe)
+	assert.Equal(t, ast.Position{Line: 2, Column: 5}, pe.Pos)
+
+	stmt, err := parser.ParseSrc("a = 1; b = invalidFn()")
+	assert.NoError(t, err)
+	assert.ErrorContains(t, e.Validate(context.Background(), stmt), "undefined symbol 'invalidFn'")
+}
+
+func TestExecutor_ValidateWarnings(t *testing.T) {
+	script := `enum Color { Red, Green, Blue }
+func f(c) { switch c { case Color.Red: 1 } }
+c = Color.Green
+switch c { case Color.Red, Color.Green: 1 }
+switch c { case Color.Red: 1; default: 2 }
+switch c { case Color.Red: 1; default: }
+switch c { case Color.Red, Color.Green, Color.Blue: 1 }
+switch 1 { case 1: 1 }`
+	e := NewExecutor(&Config{Env: envPkg.NewEnv()})
+	// the script is valid, the warnings are only given by ValidateWarnings
+	assert.NoError(t, e.Validate(context.Background(), script))
+	warnings, err := e.ValidateWarnings(context.Background(), script)
+	assert.NoError(t, err)
+	assert.Len(t, warnings, 2)
+	assert.Equal(t, "switch on enum Color is missing the cases Blue", warnings[0].Error())
+	assert.Equal(t, "switch on enum Color is missing the cases Green, Blue", warnings[1].Error())
+	var vmErr *runner.Error
+	assert.ErrorAs(t, warnings[0], &vmErr)
+	assert.Equal(t, 4, vmErr.Pos.Line)
+	var nonExhaustive *runner.NonExhaustiveSwitchError
+	assert.ErrorAs(t, warnings[1], &nonExhaustive)
+	assert.Equal(t, "Color", nonExhaustive.Enum)
+	assert.Equal(t, []string{"Green", "Blue"}, nonExhaustive.Missing)
+
+	// the errors of the script are returned instead of the warnings
+	warnings, err = e.ValidateWarnings(context.Background(), script+"\ninvalidFn()")
+	assert.ErrorContains(t, err, "undefined symbol 'invalidFn'")
+	assert.Nil(t, warnings)
+	// the script runs, and Has is not concerned by the warnings
+	_, err = e.Run(context.Background(), script)
+	assert.NoError(t, err)
+	oks, err := e.Has(context.Background(), script, []any{"a"})
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{false}, oks)
+}
+
 func TestExecutor_Has(t *testing.T) {
 	env := envPkg.NewEnv()
 	myFn1 := func() {}
@@ -0,0 +1,199 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/alaingilbert/anko/pkg/ast"
+	"github.com/alaingilbert/anko/pkg/compiler"
+	"github.com/alaingilbert/anko/pkg/parser"
+	envPkg "github.com/alaingilbert/anko/pkg/vm/env"
+	"github.com/alaingilbert/anko/pkg/vm/runner"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ErrImportCycle is returned when a script module imports itself, directly or not
+var ErrImportCycle = errors.New("import cycle")
+
+// moduleCache keeps the script modules imported by the scripts of an executor, by path.
+// A module is run once, the next imports of its path get the same env.
+type moduleCache struct {
+	mu      sync.Mutex
+	imports map[string]*moduleImport
+}
+
+// moduleImport is the import of a script module. The imports of its path happening while it runs wait for it.
+type moduleImport struct {
+	path    string
+	done    chan struct{}
+	module  envPkg.IEnv
+	err     error
+	blocked *moduleImport // import this one waits for, running or run by another script, to detect the import cycles
+}
+
+// importChainKey is the key of the imports of the modules being run, in order, in the context of a script
+type importChainKey struct{}
+
+func newModuleCache() *moduleCache {
+	return &moduleCache{imports: make(map[string]*moduleImport)}
+}
+
+// load returns the module at the path, which is run by run the first time it is imported.
+// The module is kept if it could be imported, otherwise the next import of its path runs it again.
+func (c *moduleCache) load(ctx context.Context, path string, run func(ctx context.Context) (envPkg.IEnv, error)) (envPkg.IEnv, error) {
+	chain, _ := ctx.Value(importChainKey{}).([]*moduleImport)
+	if idx := slices.IndexFunc(chain, func(imp *moduleImport) bool { return imp.path == path }); idx != -1 {
+		return nil, importCycleError(chain[idx:], path)
+	}
+	c.mu.Lock()
+	imp, ok := c.imports[path]
+	if !ok {
+		imp = &moduleImport{path: path, done: make(chan struct{})}
+		c.imports[path] = imp
+		c.setBlocked(chain, imp)
+		c.mu.Unlock()
+		defer close(imp.done)
+		defer c.setBlocked(chain, nil)
+		imp.module, imp.err = run(context.WithValue(ctx, importChainKey{}, append(slices.Clip(chain), imp)))
+		if imp.err != nil {
+			c.mu.Lock()
+			delete(c.imports, path)
+			c.mu.Unlock()
+		}
+		return imp.module, imp.err
+	}
+	// the module is imported by another script running at the same time, which can wait for the modules of the chain
+	waited := []string{path}
+	for blocked := imp.blocked; blocked != nil; blocked = blocked.blocked {
+		if idx := slices.Index(chain, blocked); idx != -1 {
+			c.mu.Unlock()
+			return nil, importCycleError(chain[idx:], append(waited, blocked.path)...)
+		}
+		waited = append(waited, blocked.path)
+	}
+	c.setBlocked(chain, imp)
+	c.mu.Unlock()
+	defer c.setBlocked(chain, nil)
+	select {
+	case <-imp.done:
+		return imp.module, imp.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// setBlocked records the import the last module of the chain waits for, nil when it is done waiting.
+// The lock is held by the caller, unless imp is nil.
+func (c *moduleCache) setBlocked(chain []*moduleImport, imp *moduleImport) {
+	if len(chain) == 0 {
+		return // imported by a script which is not a module
+	}
+	if imp == nil {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+	}
+	chain[len(chain)-1].blocked = imp
+}
+
+// importCycleError returns the error of the chain of imports importing, or waiting for, the modules at the paths
+func importCycleError(chain []*moduleImport, paths ...string) error {
+	var cycle []string
+	for _, imp := range chain {
+		cycle = append(cycle, imp.path)
+	}
+	cycle = append(cycle, paths...)
+	return fmt.Errorf("%w: %s", ErrImportCycle, strings.Join(cycle, " -> "))
+}
+
+// moduleSource is a script module found by path, either in a bundle or in the filesystem
+type moduleSource struct {
+	path   string
+	code   []byte
+	bundle *compiler.Bundle // bundle the module comes from, its imports are looked up in it
+}
+
+// importer returns the function importing the script modules for the script at the given path, which is empty
+// when it is not known. The modules are run in a child of env, and looked up in the bundle before the filesystem.
+func (e *Executor) importer(cache *moduleCache, env envPkg.IEnv, path string, bundle *compiler.Bundle, validate bool) runner.ImportFunc {
+	return func(ctx context.Context, modulePath string) (envPkg.IEnv, error) {
+		src, err := e.findModule(filepath.Dir(path), modulePath, bundle)
+		if err != nil {
+			return nil, err
+		}
+		return cache.load(ctx, src.path, func(ctx context.Context) (envPkg.IEnv, error) {
+			return e.runModule(ctx, cache, env, src, validate)
+		})
+	}
+}
+
+// findModule looks up a script module. A path starting with ./ or ../ is relative to the directory of the
+// importing script, the other relative paths are searched in the import paths, then in that directory.
+func (e *Executor) findModule(dir, modulePath string, bundle *compiler.Bundle) (*moduleSource, error) {
+	var candidates []string
+	if filepath.IsAbs(modulePath) {
+		candidates = []string{modulePath}
+	} else if strings.HasPrefix(modulePath, "./") || strings.HasPrefix(modulePath, "../") {
+		candidates = []string{filepath.Join(dir, modulePath)}
+	} else {
+		for _, importPath := range e.importPaths {
+			candidates = append(candidates, filepath.Join(importPath, modulePath))
+		}
+		candidates = append(candidates, filepath.Join(dir, modulePath))
+	}
+	for _, candidate := range candidates {
+		if bundle != nil {
+			if code, ok := bundle.Get(candidate); ok {
+				return &moduleSource{path: compiler.ModuleName(candidate), code: code, bundle: bundle}, nil
+			}
+		}
+		code, err := os.ReadFile(candidate)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		return &moduleSource{path: filepath.Clean(candidate), code: code}, nil
+	}
+	return nil, fmt.Errorf("module '%s' not found", modulePath)
+}
+
+// runModule runs a script module in a new child of env, and returns that env
+func (e *Executor) runModule(ctx context.Context, cache *moduleCache, env envPkg.IEnv, src *moduleSource, validate bool) (envPkg.IEnv, error) {
+	path, bundle := src.path, src.bundle
+	var stmt ast.Stmt
+	if bundle != nil {
+		var err error
+		if stmt, err = decode(src.code); err != nil {
+			return nil, fmt.Errorf("cannot import module '%s': %w", src.path, err)
+		}
+	} else if filepath.Ext(src.path) == ".ank" {
+		var err error
+		if stmt, err = parser.ParseFile(src.path, string(src.code)); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if stmt, bundle, err = decodeCompiled(src.code); err != nil {
+			return nil, fmt.Errorf("cannot import module '%s': %w", src.path, err)
+		}
+		if bundle != nil {
+			path = bundle.Main // the imports of a bundle are relative to its main module
+		}
+	}
+	moduleEnv := env.NewModuleEnv()
+	if stmt == nil {
+		return moduleEnv, nil
+	}
+	if !validate {
+		stmt = e.optimizeStmt(stmt)
+	}
+	if _, _, err := e.mainRun(ctx, stmt, moduleEnv, validate, nil, e.importer(cache, env, path, bundle, validate)); err != nil {
+		return nil, err
+	}
+	return moduleEnv, nil
+}
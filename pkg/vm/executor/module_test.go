@@ -0,0 +1,221 @@
+package executor
+
+import (
+	"context"
+	"github.com/alaingilbert/anko/pkg/compiler"
+	"github.com/alaingilbert/anko/pkg/parser"
+	"github.com/alaingilbert/anko/pkg/utils"
+	envPkg "github.com/alaingilbert/anko/pkg/vm/env"
+	"github.com/alaingilbert/anko/pkg/vm/runner"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, src := range files {
+		fileName := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(fileName), 0755))
+		assert.NoError(t, os.WriteFile(fileName, []byte(src), 0644))
+	}
+}
+
+func TestImport(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"lib/util.ank":    "import \"./counter.ank\" as counter\ncounter.incr()\nexport base = 20\nexport func double(x) { return x * 2 }",
+		"lib/counter.ank": "export count = 0\nexport func incr() { count++; return count }",
+	})
+	env := envPkg.NewEnv()
+	e := NewExecutor(&Config{Env: env, DefineImport: utils.Ptr(true)})
+	stmt, err := parser.ParseFile(filepath.Join(dir, "main.ank"), "import \"./lib/util.ank\" as util\nutil.double(util.base)")
+	assert.NoError(t, err)
+	val, err := e.Run(context.Background(), stmt)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(40), val)
+
+	// The modules are run once per executor, the imports of a module are relative to its directory
+	_, err = e.Run(context.Background(), `c = import("`+filepath.Join(dir, "lib/counter.ank")+`"); c.incr()`)
+	assert.NoError(t, err)
+	val, err = e.Run(context.Background(), `import "`+filepath.Join(dir, "lib/counter.ank")+`" as c; c.count`)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), val)
+
+	// The env of a module is a child of the executor env, it does not see the variables of the importing script
+	val, err = e.Run(context.Background(), `util = 1; import "`+filepath.Join(dir, "lib/util.ank")+`" as u; u.base`)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), val)
+	assert.False(t, env.HasValue("base"))
+
+	// Only the exported or capitalized symbols of a module are visible
+	_, err = e.Run(context.Background(), `import "`+filepath.Join(dir, "lib/util.ank")+`" as u; u.counter`)
+	assert.EqualError(t, err, "cannot refer to unexported symbol 'counter'")
+
+	// A new executor runs the modules again
+	e = NewExecutor(&Config{Env: envPkg.NewEnv()})
+	val, err = e.Run(context.Background(), `import "`+filepath.Join(dir, "lib/counter.ank")+`" as c; c.incr()`)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), val)
+}
+
+func TestImport_SearchPaths(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"first/a.ank":  "export name = \"first\"",
+		"second/a.ank": "export name = \"second\"",
+		"second/b.ank": "export name = \"b\"",
+	})
+	e := NewExecutor(&Config{Env: envPkg.NewEnv(), ImportPaths: []string{filepath.Join(dir, "first"), filepath.Join(dir, "second")}})
+	val, err := e.Run(context.Background(), `import "a.ank" as a; import "b.ank" as b; a.name + b.name`)
+	assert.NoError(t, err)
+	assert.Equal(t, "firstb", val)
+
+	// A path starting with ./ is not searched in the import paths
+	_, err = e.Run(context.Background(), `import "./b.ank" as b`)
+	assert.EqualError(t, err, "module './b.ank' not found")
+}
+
+func TestImport_Cycle(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"a.ank": "import \"./b.ank\" as b",
+		"b.ank": "import \"./c.ank\" as c",
+		"c.ank": "import \"./a.ank\" as a",
+	})
+	e := NewExecutor(&Config{Env: envPkg.NewEnv()})
+	src, _ := os.ReadFile(filepath.Join(dir, "a.ank"))
+	stmt, err := parser.ParseFile(filepath.Join(dir, "a.ank"), string(src))
+	assert.NoError(t, err)
+	_, err = e.Run(context.Background(), stmt)
+	assert.ErrorIs(t, err, ErrImportCycle)
+	a, b, c := filepath.Join(dir, "a.ank"), filepath.Join(dir, "b.ank"), filepath.Join(dir, "c.ank")
+	assert.EqualError(t, err, "import cycle: "+a+" -> "+b+" -> "+c+" -> "+a)
+
+	// The modules of the cycle are not kept
+	_, err = e.Run(context.Background(), `import "`+b+`" as b`)
+	assert.ErrorIs(t, err, ErrImportCycle)
+	assert.EqualError(t, err, "import cycle: "+b+" -> "+c+" -> "+a+" -> "+b)
+}
+
+func TestImport_Concurrent(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"lib/slow.ank": "wait()\nexport X = runs()",
+		"a.ank":        "wait()\nimport \"./b.ank\" as b",
+		"b.ank":        "wait()\nimport \"./a.ank\" as a",
+	})
+	env := envPkg.NewEnv()
+	var runs atomic.Int64
+	_ = env.Define("wait", func() { time.Sleep(20 * time.Millisecond) })
+	_ = env.Define("runs", func() int64 { return runs.Add(1) })
+	e := NewExecutor(&Config{Env: env, DefineImport: utils.Ptr(true)})
+
+	// The imports of a module being run wait for it, the module is run once
+	stmt, err := parser.ParseFile(filepath.Join(dir, "main.ank"), `f = func() { return import("./lib/slow.ank").X }; await all(async f(), async f())`)
+	assert.NoError(t, err)
+	val, err := e.Run(context.Background(), stmt)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{int64(1), int64(1)}, val)
+
+	// A cycle between modules imported at the same time is reported, instead of waiting forever
+	stmt, err = parser.ParseFile(filepath.Join(dir, "main.ank"), `await all(async import("./a.ank"), async import("./b.ank"))`)
+	assert.NoError(t, err)
+	_, err = e.Run(context.Background(), stmt)
+	assert.ErrorIs(t, err, ErrImportCycle)
+}
+
+func TestImport_Errors(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"syntax.ank":  "a = ]",
+		"runtime.ank": "func f() {\n  return a + 1\n}\nf()",
+		"notes.txt":   "not a script",
+		"bad.bnk":     "not bytecode",
+	})
+	e := NewExecutor(&Config{Env: envPkg.NewEnv(), DefineImport: utils.Ptr(true)})
+	_, err := e.Run(context.Background(), `import "nope.ank" as m`)
+	assert.EqualError(t, err, "module 'nope.ank' not found")
+
+	_, err = e.Run(context.Background(), `import "`+filepath.Join(dir, "syntax.ank")+`" as m`)
+	var parserErr *parser.Error
+	assert.ErrorAs(t, err, &parserErr)
+	assert.Equal(t, filepath.Join(dir, "syntax.ank"), parserErr.Filename)
+
+	// A file which is neither a script nor a compiled script is an import error
+	notesFile := filepath.Join(dir, "notes.txt")
+	_, err = e.Run(context.Background(), `import "`+notesFile+`" as m`)
+	assert.ErrorIs(t, err, compiler.ErrInvalidBytecode)
+	assert.ErrorContains(t, err, "cannot import module '"+notesFile+"'")
+	_, err = e.Run(context.Background(), `m = import("`+filepath.Join(dir, "bad.bnk")+`")`)
+	assert.ErrorIs(t, err, compiler.ErrInvalidBytecode)
+
+	stmt, err := parser.ParseFile("main.ank", "x = 1\nimport \""+filepath.Join(dir, "runtime.ank")+"\" as m")
+	assert.NoError(t, err)
+	_, err = e.Run(context.Background(), stmt)
+	var vmErr *runner.Error
+	assert.ErrorAs(t, err, &vmErr)
+	frames := make([]string, 0)
+	for _, frame := range vmErr.Stack {
+		frames = append(frames, frame.String())
+	}
+	runtimeFile := filepath.Join(dir, "runtime.ank")
+	assert.Equal(t, []string{"f (" + runtimeFile + ":2:10)", "<module> (" + runtimeFile + ":4:1)", "<main> (main.ank:2:1)"}, frames)
+
+	// Without an executor, the modules cannot be imported
+	_, err = runner.Run(&runner.Config{Ctx: context.Background(), Env: envPkg.NewEnv(), Stmt: stmt, Stats: &runner.Stats{}, MapMutex: &runner.MapLocker{}, Pause: e.pause})
+	assert.EqualError(t, err, "cannot import module '"+runtimeFile+"'")
+}
+
+func TestImport_Compiled(t *testing.T) {
+	dir := t.TempDir()
+	lib, err := compiler.Compile(`export func triple(x) { return x * 3 }`, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "lib.bnk"), lib, 0644))
+	e := NewExecutor(&Config{Env: envPkg.NewEnv()})
+	val, err := e.Run(context.Background(), `import "`+filepath.Join(dir, "lib.bnk")+`" as lib; lib.triple(2)`)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), val)
+
+	// The imports of a bundle are looked up in it, relative to the module importing them
+	main, _ := compiler.Compile(`import "./lib/x.ank" as x; export A = x.a; A + 1`, nil)
+	libX, _ := compiler.Compile(`import "./y.ank" as y; export a = y.b * 2`, nil)
+	libY, _ := compiler.Compile(`export b = 20`, nil)
+	bundle := compiler.NewBundle("app/main.ank")
+	bundle.Add("app/main.ank", main)
+	bundle.Add("app/lib/x.ank", libX)
+	bundle.Add("app/lib/y.ank", libY)
+	by, err := compiler.EncodeBundle(bundle)
+	assert.NoError(t, err)
+	val, err = e.Run(context.Background(), by)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(41), val)
+
+	// A bundle can be imported as a module
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "app.bnk"), by, 0644))
+	val, err = e.Run(context.Background(), `import "`+filepath.Join(dir, "app.bnk")+`" as app; app.A`)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(40), val)
+}
+
+func TestImport_Validate(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"ok.ank":      "export a = 1",
+		"bad.ank":     "a = b",
+		"private.ank": "a = 1",
+		"warn.ank":    "enum Color { Red, Blue }\nswitch Color.Red { case Color.Red: 1 }",
+	})
+	e := NewExecutor(&Config{Env: envPkg.NewEnv()})
+	assert.NoError(t, e.Validate(context.Background(), `import "`+filepath.Join(dir, "ok.ank")+`" as m; m.a`))
+	assert.EqualError(t, e.Validate(context.Background(), `import "`+filepath.Join(dir, "bad.ank")+`" as m`), "undefined symbol 'b'")
+	assert.EqualError(t, e.Validate(context.Background(), `import "nope.ank" as m`), "module 'nope.ank' not found")
+	assert.EqualError(t, e.Validate(context.Background(), `import "`+filepath.Join(dir, "private.ank")+`" as m; func f() { return m.a }`), "cannot refer to unexported symbol 'a'")
+	assert.EqualError(t, e.Validate(context.Background(), `module m { a = 1 }; if false { m.a }`), "cannot refer to unexported symbol 'a'")
+	warnings, err := e.ValidateWarnings(context.Background(), `import "`+filepath.Join(dir, "warn.ank")+`" as m`)
+	assert.NoError(t, err)
+	assert.Len(t, warnings, 1)
+	assert.Empty(t, e.modules.imports)
+}
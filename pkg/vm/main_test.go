@@ -122,7 +122,7 @@ func runTest(t *testing.T, test Test, testingOptions *Options) {
 }
 
 func runTestFromCompiledSource(t *testing.T, test Test, testingOptions *Options) {
-	compiled, err := compiler.Compile(test.Script, false)
+	compiled, err := compiler.Compile(test.Script, nil)
 	if test.ParseErrorFunc != nil {
 		(*test.ParseErrorFunc)(t, err)
 	} else if err != nil && test.ParseError != nil {
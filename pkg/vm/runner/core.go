@@ -3,6 +3,7 @@
 package runner
 
 import (
+	"errors"
 	"fmt"
 	"github.com/alaingilbert/anko/pkg/vm/env"
 	"os"
@@ -23,6 +24,10 @@ func Import(env env.IEnv) env.IEnv {
 	_ = env.Define("println", fmt.Println)
 	_ = env.Define("printf", fmt.Printf)
 	_ = env.Define("close", closeFn)
+	_ = env.Define("errorIs", errorIsFn)
+	_ = env.Define("errorAs", errorAsFn)
+	_ = env.Define("ErrTimeout", ErrTimeout)
+	_ = env.Define("ErrCanceled", ErrCanceled)
 
 	ImportToX(env)
 
@@ -112,6 +117,27 @@ func panicFn(e any) {
 	panic(e)
 }
 
+// Returns either or not an error matches the target, which is an error of its chain or the code of a thrown value
+// > errorIs(e, "not_found")
+// true
+func errorIsFn(err error, target any) bool {
+	return errorIs(err, target)
+}
+
+// Returns the first error of the chain having the type of the target, nil if there is none
+// > errorAs(e, new(PathError))
+func errorAsFn(err error, target any) any {
+	t := reflect.TypeOf(target)
+	if t == nil || !t.Implements(errorType) {
+		panic("errorAs target must be an error")
+	}
+	out := reflect.New(t)
+	if errors.As(err, out.Interface()) {
+		return out.Elem().Interface()
+	}
+	return nil
+}
+
 // Close a channel (or anything that can be closed)
 func closeFn(e any) {
 	reflect.ValueOf(e).Close()
@@ -7,6 +7,7 @@ import (
 	"github.com/alaingilbert/anko/pkg/ast"
 	vmUtils "github.com/alaingilbert/anko/pkg/vm/utils"
 	"reflect"
+	"strings"
 )
 
 // Error provides a convenient interface for handling runtime error.
@@ -14,14 +15,153 @@ import (
 type Error struct {
 	Message string
 	Pos     ast.Position
+	Span    ast.Span // source range of the failing node, and the file it comes from when known
+	Stack   []Frame  // script functions the error went through, innermost first
 	cause   error
+	call    ast.Pos // call expression the error came out of, it is the position of the next frame
 }
 
+// Frame is a script function call of the stack of an Error
+type Frame struct {
+	Func string       // name of the function, <anonymous> for a function literal, <main> or <module> for the top level of a script
+	Pos  ast.Position // position of the error in the function, or of the call to the previous frame
+	Span ast.Span
+}
+
+// String returns the frame as "name (file:line:column)"
+func (f Frame) String() string {
+	loc := fmt.Sprintf("%d:%d", f.Pos.Line, f.Pos.Column)
+	if f.Span.File != "" {
+		loc = f.Span.File + ":" + loc
+	}
+	return f.Func + " (" + loc + ")"
+}
+
+// StackTrace returns the frames of the stack, one per line, innermost first
+func (e *Error) StackTrace() string {
+	var out strings.Builder
+	for _, f := range e.Stack {
+		out.WriteString("\tat " + f.String() + "\n")
+	}
+	return out.String()
+}
+
+// addFrame adds the frame of the function the error is coming out of
+func (e *Error) addFrame(name string) {
+	frame := Frame{Func: name, Pos: e.Pos, Span: e.Span}
+	if e.call != nil {
+		frame.Pos, frame.Span = getPos(e.call), getSpan(e.call)
+		e.call = nil
+	} else if len(e.Stack) > 0 {
+		return // went through host code which did not report the call
+	}
+	e.Stack = append(e.Stack, frame)
+}
+
+// setCallSite records the call expression a script error came out of
+func setCallSite(err error, call ast.Pos) {
+	var vmErr *Error
+	if errors.As(err, &vmErr) && len(vmErr.Stack) > 0 && vmErr.call == nil {
+		vmErr.call = call
+	}
+}
+
+// addMainFrame adds the frame of the top level of the script to a runtime error
+func addMainFrame(err error) error {
+	var vmErr *Error
+	if err != nil && !errors.Is(err, ErrReturn) && errors.As(err, &vmErr) {
+		vmErr.addFrame(mainFrameName)
+	}
+	return err
+}
+
+// setModuleFrame names the top level frame of a runtime error coming out of an imported module
+func setModuleFrame(err error) {
+	var vmErr *Error
+	if errors.As(err, &vmErr) && len(vmErr.Stack) > 0 {
+		vmErr.Stack[len(vmErr.Stack)-1].Func = moduleFrameName
+	}
+}
+
+const (
+	mainFrameName      = "<main>"
+	moduleFrameName    = "<module>"
+	anonymousFrameName = "<anonymous>"
+)
+
 // Unwrap returns the wrapped error.
 func (e *Error) Unwrap() error {
 	return e.cause
 }
 
+// Code returns the code of the value thrown by a script, nil if the error was not thrown by a script
+func (e *Error) Code() any {
+	var thrown *ThrownError
+	if errors.As(e, &thrown) {
+		return thrown.Code
+	}
+	return nil
+}
+
+// Data returns the data of the structured error thrown by a script, nil if there is none
+func (e *Error) Data() any {
+	var thrown *ThrownError
+	if errors.As(e, &thrown) {
+		return thrown.Data
+	}
+	return nil
+}
+
+// ThrownError is the error of a value thrown by a script which is not an error.
+// A map with a code, a message or some data is a structured error, ex: throw {"code": 404, "message": "not found"}.
+// The code of any other value is the value itself.
+type ThrownError struct {
+	Code    any
+	Message string
+	Data    any
+}
+
+func (e *ThrownError) Error() string {
+	return e.Message
+}
+
+// newThrownError makes the error of a value thrown by a script
+func newThrownError(v reflect.Value) *ThrownError {
+	v = elemIfInterface(v)
+	if v.Kind() == reflect.Map && (v.Type().Key().Kind() == reflect.String || v.Type().Key().Kind() == reflect.Interface) {
+		get := func(key string) (any, bool) {
+			if field := v.MapIndex(reflect.ValueOf(key).Convert(v.Type().Key())); field.IsValid() {
+				return field.Interface(), true
+			}
+			return nil, false
+		}
+		code, hasCode := get("code")
+		message, hasMessage := get("message")
+		data, hasData := get("data")
+		if hasCode || hasMessage || hasData {
+			out := &ThrownError{Code: code, Message: fmt.Sprint(code), Data: data}
+			if hasMessage {
+				out.Message = fmt.Sprint(message)
+			}
+			return out
+		}
+	}
+	if !v.IsValid() {
+		return &ThrownError{Message: fmt.Sprint(nil)}
+	}
+	return &ThrownError{Code: v.Interface(), Message: fmt.Sprint(v.Interface())}
+}
+
+// errorIs returns either or not the error matches the target. The target is either an error found in the chain
+// of the error, or the code of a value thrown by a script.
+func errorIs(err error, target any) bool {
+	if targetErr, ok := target.(error); ok {
+		return errors.Is(err, targetErr)
+	}
+	var thrown *ThrownError
+	return errors.As(err, &thrown) && thrown.Code != nil && equal(reflect.ValueOf(thrown.Code), reflect.ValueOf(target))
+}
+
 type IsVmFunc struct{ context.Context }
 
 var (
@@ -54,6 +194,10 @@ var (
 	ErrContinue = errors.New("unexpected continue statement")
 	// ErrReturn when there is an unexpected return statement
 	ErrReturn = errors.New("unexpected return statement")
+	// ErrTimeout when the timeout of an await expression or of a with statement expires before they are done
+	ErrTimeout = errors.New("timed out")
+	// ErrCanceled when the block of a with cancel statement is canceled by its channel
+	ErrCanceled = errors.New("canceled")
 )
 
 type BreakErr struct {
@@ -108,6 +252,13 @@ func getPos(pos ast.Pos) ast.Position {
 	return out
 }
 
+func getSpan(pos ast.Pos) ast.Span {
+	if pos == nil || reflect.ValueOf(pos).IsNil() {
+		return ast.Span{}
+	}
+	return pos.Span()
+}
+
 // newError makes error interface with message.
 // This doesn't overwrite last error.
 func newError(pos ast.Pos, err error) error {
@@ -115,7 +266,7 @@ func newError(pos ast.Pos, err error) error {
 	if errors.As(err, &vmErr) {
 		return err
 	}
-	return &Error{Message: err.Error(), Pos: getPos(pos), cause: err}
+	return &Error{Message: err.Error(), Pos: getPos(pos), Span: getSpan(pos), cause: err}
 }
 
 // newStringError makes error interface with message.
@@ -197,3 +348,18 @@ func (e *TypeCannotBeAssignedError) Error() string {
 	}
 	return out
 }
+
+// NonExhaustiveSwitchError is the warning of the validation for a switch on an enum value which has no default case,
+// and no case for some of the values of the enum
+type NonExhaustiveSwitchError struct {
+	Enum    string
+	Missing []string
+}
+
+func NewNonExhaustiveSwitchError(enum string, missing []string) *NonExhaustiveSwitchError {
+	return &NonExhaustiveSwitchError{Enum: enum, Missing: missing}
+}
+
+func (e *NonExhaustiveSwitchError) Error() string {
+	return fmt.Sprintf("switch on enum %s is missing the cases %s", e.Enum, strings.Join(e.Missing, ", "))
+}
@@ -154,25 +154,25 @@ module rectangle {
 	_length = 1
 	_width = 1
 	
-	func setLength (length) {
+	export func setLength (length) {
 		if length <= 0 {
 			return
 		}
 		_length = length
 	}
 	
-	func setWidth (width) {
+	export func setWidth (width) {
 		if width <= 0 {
 			return
 		}
 		_width = width
 	}
 	
-	func area () {
+	export func area () {
 		return _length * _width
 	}
 	
-	func perimeter () {
+	export func perimeter () {
 		return 2 * (_length + _width)
 	}
  }
@@ -2,11 +2,13 @@ package runner
 
 import (
 	"context"
+	"fmt"
 	"github.com/alaingilbert/anko/pkg/ast"
 	"github.com/alaingilbert/anko/pkg/utils/ratelimitanything"
 	"github.com/alaingilbert/anko/pkg/utils/stateCh"
 	envPkg "github.com/alaingilbert/anko/pkg/vm/env"
 	"reflect"
+	"slices"
 	"sync"
 	"sync/atomic"
 )
@@ -30,6 +32,7 @@ type VmParams struct {
 	Validate      bool
 	has           map[any]bool
 	ValidateLater map[string]ast.Stmt
+	yield         func(reflect.Value) error // runs the loop iterating the generator being run, nil outside of a generator
 }
 
 func NewVmParams(ctx context.Context,
@@ -70,6 +73,30 @@ type Config struct {
 	Validate    bool
 	DbgEnabled  bool
 	Has         map[any]bool
+	Import      ImportFunc // imports the script modules, nil if they cannot be imported
+	Warnings    *[]error   // when set, the validation adds to it the issues which do not stop the script from running
+}
+
+// ImportFunc imports the script module at the given path, and returns its env
+type ImportFunc func(ctx context.Context, path string) (envPkg.IEnv, error)
+
+type importFuncKey struct{}
+
+// importModule imports a script module with the ImportFunc of the script being run.
+// The error of a module is copied, it is the same one for the scripts importing the module at the same time,
+// and the frames of each of them are added to it.
+func importModule(ctx context.Context, path string) (envPkg.IEnv, error) {
+	importFn, _ := ctx.Value(importFuncKey{}).(ImportFunc)
+	if importFn == nil {
+		return nil, fmt.Errorf("cannot import module '%s'", path)
+	}
+	module, err := importFn(ctx, path)
+	if vmErr, ok := err.(*Error); ok {
+		errCopy := *vmErr
+		errCopy.Stack = slices.Clone(vmErr.Stack)
+		err = &errCopy
+	}
+	return module, err
 }
 
 func Run(config *Config) (reflect.Value, error) {
@@ -83,7 +110,23 @@ func Run(config *Config) (reflect.Value, error) {
 	// So we need a way to stop the vm from another thread...
 	rvCh := make(chan Result)
 
-	vmp := NewVmParams(config.Ctx, rvCh, config.Stats, config.ProtectMaps, config.MapMutex,
+	// The ImportFunc is carried by the context, for the import function and the functions of the script to find it
+	ctx := config.Ctx
+	if config.Import != nil {
+		ctx = context.WithValue(ctx, importFuncKey{}, config.Import)
+	}
+
+	// The async tasks started by the script are canceled when the run is over
+	runCtx, cancelTasks := context.WithCancel(context.Background())
+	defer cancelTasks()
+	ctx = context.WithValue(ctx, tasksKey{}, runCtx)
+
+	// The warnings of the validation are collected from the whole script
+	if validate && config.Warnings != nil {
+		ctx = context.WithValue(ctx, warningsKey{}, config.Warnings)
+	}
+
+	vmp := NewVmParams(ctx, rvCh, config.Stats, config.ProtectMaps, config.MapMutex,
 		config.Pause, config.RateLimit, dbgEnabled, validate, config.Has, validateLater)
 
 	go func() {
@@ -115,7 +158,7 @@ func Run(config *Config) (reflect.Value, error) {
 		}
 	}
 
-	return result.Value, result.Error
+	return result.Value, addMainFrame(result.Error)
 }
 
 func run(vmp *VmParams, env envPkg.IEnv, stmt ast.Stmt) (reflect.Value, error) {
@@ -1,9 +1,11 @@
 package runner
 
 import (
+	"context"
 	"fmt"
 	"github.com/alaingilbert/anko/pkg/ast"
 	"github.com/alaingilbert/anko/pkg/packages"
+	"github.com/alaingilbert/anko/pkg/utils"
 	envPkg "github.com/alaingilbert/anko/pkg/vm/env"
 	"reflect"
 )
@@ -301,13 +303,21 @@ func makeValueDefault(t reflect.Type) (reflect.Value, error) {
 	return reflect.New(t).Elem(), nil
 }
 
-// DefineImport defines the vm import command that will import packages and package types when wanted
+// DefineImport defines the vm import command that will import packages and package types when wanted.
+// A source ending with .ank or .bnk is a script module, imported like with the import statement.
 func DefineImport(e envPkg.IEnv) {
-	_ = e.Define("import", importFn(e))
+	_ = e.DefineCtx("import", importFn(e))
 }
 
-func importFn(e envPkg.IEnv) func(string) envPkg.IEnv {
-	return func(source string) envPkg.IEnv {
+func importFn(e envPkg.IEnv) func(context.Context, string) envPkg.IEnv {
+	return func(ctx context.Context, source string) envPkg.IEnv {
+		if utils.IsModulePath(source) {
+			module, err := importModule(ctx, source)
+			if err != nil {
+				panic(err)
+			}
+			return module
+		}
 		methods, ok := packages.Packages.Get(source)
 		if !ok {
 			panic(fmt.Sprintf("package '%s' not found", source))
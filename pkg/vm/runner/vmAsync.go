@@ -0,0 +1,186 @@
+package runner
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+	envPkg "github.com/alaingilbert/anko/pkg/vm/env"
+)
+
+// Task is returned by an async call, ex: t = async f(x). The function is run by a new goroutine, with a context
+// canceled when the script run is over, and its result is given by the await expressions.
+type Task struct {
+	done   chan struct{}
+	cancel context.CancelFunc
+	value  reflect.Value
+	err    error
+}
+
+// String returns the name of the task
+func (t *Task) String() string {
+	return "task"
+}
+
+// tasksKey is the key of the context of a script run, done when the run is over, in the context of the script
+type tasksKey struct{}
+
+// toTask returns the task held by the value, if it is one
+func toTask(v reflect.Value) (*Task, bool) {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil, false
+	}
+	t, ok := v.Interface().(*Task)
+	return t, ok
+}
+
+// startTask runs the call by a new goroutine. The task is canceled when the context of the script is done,
+// or when the script run is over.
+func startTask(vmp *VmParams, env envPkg.IEnv, call *ast.CallExpr) *Task {
+	ctx, cancel := context.WithCancel(vmp.ctx)
+	task := &Task{done: make(chan struct{}), cancel: cancel}
+	stop := func() bool { return false }
+	if runCtx, ok := vmp.ctx.Value(tasksKey{}).(context.Context); ok {
+		stop = context.AfterFunc(runCtx, cancel)
+	}
+	taskVmp := NewVmParams(ctx, vmp.rvCh, vmp.stats, vmp.protectMaps, vmp.mapMutex, vmp.pause, vmp.rateLimit, vmp.DbgEnabled, vmp.Validate, vmp.has, vmp.ValidateLater)
+	run := func() {
+		defer close(task.done)
+		defer cancel()
+		defer stop()
+		task.value, task.err = invokeExpr(taskVmp, env, call)
+	}
+	// the call is validated right away, the task is done
+	if vmp.Validate {
+		run()
+	} else {
+		go run()
+	}
+	return task
+}
+
+// invokeAsyncExpr starts a task running the call of an async expression. The function and its arguments are
+// evaluated before the task is started.
+func invokeAsyncExpr(vmp *VmParams, env envPkg.IEnv, e *ast.AsyncExpr) (reflect.Value, error) {
+	var f reflect.Value
+	var callable *ast.Callable
+	var err error
+	switch call := e.Expr.(type) {
+	case *ast.CallExpr:
+		if f = call.Func; !f.IsValid() {
+			f, err = env.GetValue(call.Name)
+		}
+		callable = call.Callable
+	case *ast.AnonCallExpr:
+		f, err = invokeExpr(vmp, env, call.Expr)
+		callable = call.Callable
+	default:
+		return nilValue, newStringError(e, "async needs a function call")
+	}
+	if err != nil {
+		return nilValue, newError(e.Expr, err)
+	}
+	call := &ast.CallExpr{Func: f, Callable: callable}
+	call.SetPosition(e.Expr.Position())
+	call.SetSpan(e.Expr.Span())
+	if call, err = spreadCallArgs(vmp, env, call); err != nil {
+		return nilValue, err
+	}
+	return reflect.ValueOf(startTask(vmp, env, call)), nil
+}
+
+// invokeAwaitExpr waits for the tasks of an await expression.
+// A task gives its value, all the tasks give the list of their values, and a race gives the value of the first task
+// done. The error of a task is returned as soon as it is done, the other tasks are canceled, as well as the tasks
+// still running when the timeout expires.
+func invokeAwaitExpr(vmp *VmParams, env envPkg.IEnv, e *ast.AwaitExpr) (reflect.Value, error) {
+	var tasks []*Task
+	var notTask ast.Expr
+	var notTaskValue reflect.Value
+	err := invokeElements(vmp, env, e.Exprs, func(expr ast.Expr, rv reflect.Value) {
+		if task, ok := toTask(elemIfInterfaceNNil(rv)); ok {
+			tasks = append(tasks, task)
+		} else if notTask == nil {
+			notTask, notTaskValue = expr, rv
+		}
+	})
+	if err != nil {
+		return nilValue, err
+	}
+	if notTask != nil {
+		if vmp.Validate {
+			return nilValue, nil
+		}
+		return nilValue, newStringError(notTask, "cannot await type "+kindOrType(notTaskValue))
+	}
+	if e.Kind == ast.AwaitRace && len(tasks) == 0 {
+		return nilValue, newStringError(e, "cannot await the race of no tasks")
+	}
+	var timeout <-chan time.Time
+	if e.Timeout != nil {
+		rv, err := invokeExpr(vmp, env, e.Timeout)
+		if err != nil {
+			return nilValue, newError(e.Timeout, err)
+		}
+		d, ok := toDuration(rv)
+		if !ok {
+			return nilValue, newStringError(e.Timeout, "timeout must be a duration, not "+kindOrType(rv))
+		}
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	return awaitTasks(vmp, e, tasks, timeout)
+}
+
+func awaitTasks(vmp *VmParams, e *ast.AwaitExpr, tasks []*Task, timeout <-chan time.Time) (reflect.Value, error) {
+	cancelTasks := func() {
+		for _, task := range tasks {
+			task.cancel()
+		}
+	}
+	cases := []reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(vmp.ctx.Done())},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timeout)}}
+	for _, task := range tasks {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(task.done)})
+	}
+	values := make([]any, len(tasks))
+	for remaining := len(tasks); remaining > 0; remaining-- {
+		chosen, _, _ := reflect.Select(cases)
+		switch chosen {
+		case 0:
+			return nilValue, vmp.ctx.Err()
+		case 1:
+			cancelTasks()
+			return nilValue, newError(e, ErrTimeout)
+		}
+		cases[chosen].Chan = reflect.Value{} // the task done is not selected again
+		task := tasks[chosen-2]
+		if task.err != nil {
+			cancelTasks()
+			return nilValue, newError(e, task.err)
+		}
+		switch e.Kind {
+		case ast.AwaitTask:
+			return task.value, nil
+		case ast.AwaitRace:
+			cancelTasks()
+			return task.value, nil
+		}
+		if task.value.IsValid() && task.value.CanInterface() {
+			values[chosen-2] = task.value.Interface()
+		}
+	}
+	return reflect.ValueOf(values), nil
+}
+
+// toDuration returns the duration held by a value, a number being a count of nanoseconds
+func toDuration(rv reflect.Value) (time.Duration, bool) {
+	rv = elemIfInterfaceNNil(rv)
+	if !rv.IsValid() || !isNum(rv) {
+		return 0, false
+	}
+	return time.Duration(toInt64(rv)), true
+}
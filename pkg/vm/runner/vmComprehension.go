@@ -0,0 +1,97 @@
+package runner
+
+import (
+	"reflect"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+	envPkg "github.com/alaingilbert/anko/pkg/vm/env"
+)
+
+// comprehensionStmt is the body of the for-in loop a comprehension is evaluated with.
+// It collects the key and the value of each iteration passing the filter of the comprehension.
+type comprehensionStmt struct {
+	ast.StmtImpl
+	expr   *ast.ComprehensionExpr
+	keys   []reflect.Value
+	values []reflect.Value
+}
+
+// invokeComprehensionExpr evaluates a list or a map comprehension, ex: [x * 2 for x in a if x > 3].
+// The values are iterated like the ones of a for-in loop, counting the cycles and stopping when the context is done.
+// The slice or the map built is typed by the values collected when they all have the same type.
+func invokeComprehensionExpr(vmp *VmParams, env envPkg.IEnv, e *ast.ComprehensionExpr) (reflect.Value, error) {
+	body := &comprehensionStmt{expr: e}
+	body.SetPosition(e.Position())
+	loop := &ast.ForStmt{Vars: e.Vars, Value: e.Value, Pattern: e.Pattern, Stmt: body}
+	loop.SetPosition(e.Position())
+	if _, err := runForStmt(vmp, env, loop); err != nil {
+		return nilValue, err
+	}
+	if e.Key == nil {
+		valueType := commonType(body.values)
+		slice := reflect.MakeSlice(reflect.SliceOf(valueType), len(body.values), len(body.values))
+		for i, rv := range body.values {
+			slice.Index(i).Set(valueOrZero(rv, valueType))
+		}
+		return slice, nil
+	}
+	keyType, valueType := commonType(body.keys), commonType(body.values)
+	m := reflect.MakeMapWithSize(reflect.MapOf(keyType, valueType), len(body.keys))
+	for i, key := range body.keys {
+		m.SetMapIndex(valueOrZero(key, keyType), valueOrZero(body.values[i], valueType))
+	}
+	return m, nil
+}
+
+func runComprehensionStmt(vmp *VmParams, env envPkg.IEnv, stmt *comprehensionStmt) (reflect.Value, error) {
+	e := stmt.expr
+	if e.Cond != nil {
+		rv, err := invokeExpr(vmp, env, e.Cond)
+		if err != nil {
+			return nilValue, newError(e.Cond, err)
+		}
+		if !toBool(rv) {
+			return nilValue, nil
+		}
+	}
+	if e.Key != nil {
+		key, err := invokeExpr(vmp, env, e.Key)
+		if err != nil {
+			return nilValue, newError(e.Key, err)
+		}
+		key = elemIfInterface(key)
+		if key.IsValid() && !key.Type().Comparable() {
+			return nilValue, newStringError(e.Key, "cannot use type "+key.Type().String()+" as map key")
+		}
+		stmt.keys = append(stmt.keys, key)
+	}
+	rv, err := invokeExpr(vmp, env, e.Expr)
+	if err != nil {
+		return nilValue, newError(e.Expr, err)
+	}
+	stmt.values = append(stmt.values, rv)
+	return nilValue, nil
+}
+
+// commonType returns the type of the values if they all have the same one, otherwise the interface type.
+func commonType(values []reflect.Value) reflect.Type {
+	var t reflect.Type
+	for _, rv := range values {
+		if !rv.IsValid() || t != nil && rv.Type() != t {
+			return interfaceType
+		}
+		t = rv.Type()
+	}
+	if t == nil {
+		return interfaceType
+	}
+	return t
+}
+
+// valueOrZero returns the value, or the zero value of the type if it is not valid.
+func valueOrZero(rv reflect.Value, t reflect.Type) reflect.Value {
+	if !rv.IsValid() {
+		return reflect.Zero(t)
+	}
+	return rv
+}
@@ -0,0 +1,161 @@
+package runner
+
+import (
+	"reflect"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+	envPkg "github.com/alaingilbert/anko/pkg/vm/env"
+)
+
+// destructure unpacks rv into the targets of a destructuring pattern, calling assign with each target and its value.
+// A list pattern takes the values of a slice or an array by position, and its rest element takes the remaining ones.
+// A map pattern takes the values of a map or the fields of a struct by key, and its rest element takes a map of the
+// remaining ones. The missing values are nil.
+func destructure(vmp *VmParams, env envPkg.IEnv, pattern ast.Expr, rv reflect.Value, assign func(ast.Expr, reflect.Value) error) error {
+	switch p := pattern.(type) {
+	case *ast.ArrayExpr:
+		return destructureArray(vmp, env, p, rv, assign)
+	case *ast.MapExpr:
+		return destructureMap(vmp, env, p, rv, assign)
+	}
+	return assign(pattern, rv)
+}
+
+func destructureArray(vmp *VmParams, env envPkg.IEnv, pattern *ast.ArrayExpr, rv reflect.Value, assign func(ast.Expr, reflect.Value) error) error {
+	rv = elemIfInterfaceNNil(rv)
+	length := 0
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		length = rv.Len()
+	default:
+		if !vmp.Validate {
+			return newStringError(pattern, "cannot destructure "+kindOrType(rv)+" into a list pattern")
+		}
+	}
+	for i, target := range pattern.Exprs.Exprs {
+		if spread, ok := target.(*ast.SpreadExpr); ok {
+			rest := make([]any, 0)
+			for j := i; j < length; j++ {
+				rest = append(rest, rv.Index(j).Interface())
+			}
+			return destructure(vmp, env, spread.Expr, reflect.ValueOf(rest), assign)
+		}
+		v := nilValue
+		if i < length {
+			v = elemIfInterface(rv.Index(i))
+		}
+		if err := destructure(vmp, env, target, v, assign); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func destructureMap(vmp *VmParams, env envPkg.IEnv, pattern *ast.MapExpr, rv reflect.Value, assign func(ast.Expr, reflect.Value) error) error {
+	rv = elemIfInterfaceNNil(rv)
+	if rv.Kind() == reflect.Pointer && rv.Elem().Kind() == reflect.Struct {
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Map, reflect.Struct:
+	default:
+		if !vmp.Validate {
+			return newStringError(pattern, "cannot destructure "+kindOrType(rv)+" into a map pattern")
+		}
+	}
+	used := make(map[any]struct{}, len(pattern.Keys.Exprs))
+	for i, keyExpr := range pattern.Keys.Exprs {
+		if spread, ok := keyExpr.(*ast.SpreadExpr); ok {
+			return destructure(vmp, env, spread.Expr, reflect.ValueOf(restOfMap(vmp, rv, used)), assign)
+		}
+		key, err := invokeExpr(vmp, env, keyExpr)
+		if err != nil {
+			return newError(keyExpr, err)
+		}
+		if key.IsValid() && key.CanInterface() && key.Type().Comparable() {
+			used[key.Interface()] = struct{}{}
+		}
+		v := nilValue
+		switch rv.Kind() {
+		case reflect.Map:
+			if mv := getMapIndex(vmp, key, rv); mv.IsValid() {
+				v = elemIfInterface(mv)
+			}
+		case reflect.Struct:
+			key = elemIfInterfaceNNil(key)
+			if key.Kind() != reflect.String {
+				return newStringError(keyExpr, "struct field name must be a string, not "+kindOrType(key))
+			}
+			if field := rv.FieldByName(key.String()); field.IsValid() && field.CanInterface() {
+				v = elemIfInterface(field)
+			}
+		}
+		if err := destructure(vmp, env, pattern.Values.Exprs[i], v, assign); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restOfMap returns the entries of a map, or the exported fields of a struct, whose keys are not used by a pattern.
+func restOfMap(vmp *VmParams, rv reflect.Value, used map[any]struct{}) map[any]any {
+	rest := make(map[any]any)
+	switch rv.Kind() {
+	case reflect.Map:
+		for _, key := range rv.MapKeys() {
+			if _, ok := used[key.Interface()]; !ok {
+				rest[key.Interface()] = readMapIndex(rv, key, vmp).Interface()
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < rv.NumField(); i++ {
+			name := rv.Type().Field(i).Name
+			if _, ok := used[name]; !ok && rv.Type().Field(i).IsExported() {
+				rest[name] = rv.Field(i).Interface()
+			}
+		}
+	}
+	return rest
+}
+
+// kindOrType returns the name of the type of a value for the destructuring errors, or nil.
+func kindOrType(rv reflect.Value) string {
+	if !rv.IsValid() || rv.Kind() == reflect.Interface && rv.IsNil() {
+		return "nil"
+	}
+	return rv.Type().String()
+}
+
+// definePattern defines the variables of a destructuring pattern in env with the values unpacked from rv.
+func definePattern(vmp *VmParams, env envPkg.IEnv, pattern ast.Expr, rv reflect.Value) error {
+	return destructure(vmp, env, pattern, rv, func(target ast.Expr, v reflect.Value) error {
+		ident, ok := target.(*ast.IdentExpr)
+		if !ok {
+			return newError(target, ErrInvalidOperation)
+		}
+		if err := env.DefineValue(ident.Lit, v); err != nil {
+			return newError(target, err)
+		}
+		return nil
+	})
+}
+
+// defineForVars defines the variables of a for-in loop with the values of an iteration.
+// The first value is unpacked into the destructuring pattern of the loop if it has one.
+func defineForVars(vmp *VmParams, env envPkg.IEnv, stmt *ast.ForStmt, values ...reflect.Value) error {
+	if stmt.Pattern != nil {
+		return definePattern(vmp, env, stmt.Pattern, values[0])
+	}
+	for i := 0; i < len(stmt.Vars) && i < len(values); i++ {
+		_ = env.DefineValue(stmt.Vars[i], values[i])
+	}
+	return nil
+}
+
+// defineParam defines a parameter of a function in env with the value of its argument.
+func defineParam(vmp *VmParams, env envPkg.IEnv, param *ast.ParamExpr, rv reflect.Value) error {
+	if param.Pattern != nil {
+		return definePattern(vmp, env, param.Pattern, rv)
+	}
+	return env.DefineValue(param.Name, rv)
+}
@@ -0,0 +1,103 @@
+package runner
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+	envPkg "github.com/alaingilbert/anko/pkg/vm/env"
+	vmUtils "github.com/alaingilbert/anko/pkg/vm/utils"
+)
+
+// warningsKey is the key of the warnings collected by the validation, in the context of the script
+type warningsKey struct{}
+
+// warn adds a warning to the ones of the validation
+func warn(vmp *VmParams, err error) {
+	if warnings, ok := vmp.ctx.Value(warningsKey{}).(*[]error); ok {
+		*warnings = append(*warnings, err)
+	}
+}
+
+// runEnumStmt defines an enum, its values are given by its members, ex: Color.Red
+func runEnumStmt(env envPkg.IEnv, stmt *ast.EnumStmt) (reflect.Value, error) {
+	enum := vmUtils.NewEnum(stmt.Name, stmt.Names)
+	if err := env.DefineValue(stmt.Name, reflect.ValueOf(enum)); err != nil {
+		return nilValue, newError(stmt, err)
+	}
+	return nilValue, nil
+}
+
+// invokeEnumMember returns the value of the enum named by the member expression
+func invokeEnumMember(e *ast.MemberExpr, enum *vmUtils.Enum) (reflect.Value, error) {
+	value, ok := enum.Value(e.Name)
+	if !ok {
+		if e.Optional {
+			return nilValue, nil
+		}
+		return nilValue, newErrorf(e, "enum %s has no value %s", enum.Name, e.Name)
+	}
+	return reflect.ValueOf(value), nil
+}
+
+// checkSwitchCases warns about a switch without a default case, on a value of an enum, which misses some of its values.
+// The enum is the one of the value switched on, or of the values of the cases when it is not known by the validation.
+func checkSwitchCases(vmp *VmParams, stmt *ast.SwitchStmt, rv reflect.Value, caseValues []reflect.Value) {
+	if stmt.Default != nil {
+		return
+	}
+	var enum *vmUtils.Enum
+	if value, ok := toEnumValue(rv); ok {
+		enum = value.Enum
+	}
+	covered := make(map[int]bool)
+	for _, v := range caseValues {
+		value, ok := toEnumValue(v)
+		if !ok || enum != nil && value.Enum != enum {
+			continue
+		}
+		enum = value.Enum
+		covered[value.Index] = true
+	}
+	if enum == nil {
+		return
+	}
+	var missing []string
+	for i, name := range enum.Names {
+		if !covered[i] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		warn(vmp, newError(stmt, NewNonExhaustiveSwitchError(enum.Name, missing)))
+	}
+}
+
+// toEnumValue returns the enum value held by the value, if it is one
+func toEnumValue(rv reflect.Value) (vmUtils.EnumValue, bool) {
+	rv = elemIfInterfaceNNil(rv)
+	if !rv.IsValid() || !rv.CanInterface() {
+		return vmUtils.EnumValue{}, false
+	}
+	value, ok := rv.Interface().(vmUtils.EnumValue)
+	return value, ok
+}
+
+// checkEnumOperands returns an error if an operand of the operator is an enum value and the operator is not defined on
+// enum values. They can only be compared for equality, and concatenated to a string.
+func checkEnumOperands(operator string, lhsV, rhsV reflect.Value) error {
+	_, lhsEnum := toEnumValue(lhsV)
+	_, rhsEnum := toEnumValue(rhsV)
+	if !lhsEnum && !rhsEnum {
+		return nil
+	}
+	switch operator {
+	case "==", "!=", "&&", "||":
+		return nil
+	case "+":
+		if lhsV.Kind() == reflect.String || rhsV.Kind() == reflect.String {
+			return nil
+		}
+	}
+	return fmt.Errorf("operator %s is not defined on enum values", operator)
+}
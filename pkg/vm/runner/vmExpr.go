@@ -6,10 +6,13 @@ import (
 	"github.com/alaingilbert/anko/pkg/utils"
 	envPkg "github.com/alaingilbert/anko/pkg/vm/env"
 	vmUtils "github.com/alaingilbert/anko/pkg/vm/utils"
+	"go/token"
 	"math"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // invokeExpr evaluates one expression.
@@ -22,14 +25,20 @@ func invokeExpr(vmp *VmParams, env envPkg.IEnv, expr ast.Expr) (reflect.Value, e
 	switch e := expr.(type) {
 	case *ast.NumberExpr:
 		return invokeNumberExpr(vmp, env, e)
+	case *ast.DurationExpr:
+		return invokeDurationExpr(vmp, env, e)
 	case *ast.IdentExpr:
 		return invokeIdentExpr(vmp, env, e)
 	case *ast.StringExpr:
 		return invokeStringExpr(vmp, env, e)
+	case *ast.InterpExpr:
+		return invokeInterpExpr(vmp, env, e)
 	case *ast.ArrayExpr:
 		return invokeArrayExpr(vmp, env, e)
 	case *ast.MapExpr:
 		return invokeMapExpr(vmp, env, e)
+	case *ast.StructExpr:
+		return invokeStructExpr(vmp, env, e)
 	case *ast.DerefExpr:
 		return invokeDerefExpr(vmp, env, e)
 	case *ast.AddrExpr:
@@ -76,11 +85,29 @@ func invokeExpr(vmp *VmParams, env envPkg.IEnv, expr ast.Expr) (reflect.Value, e
 		return invokeDeleteExpr(vmp, env, e)
 	case *ast.IncludeExpr:
 		return invokeIncludeExpr(vmp, env, e)
+	case *ast.ComprehensionExpr:
+		return invokeComprehensionExpr(vmp, env, e)
+	case *ast.AsyncExpr:
+		return invokeAsyncExpr(vmp, env, e)
+	case *ast.AwaitExpr:
+		return invokeAwaitExpr(vmp, env, e)
+	case *ast.SpreadExpr:
+		return nilValue, newStringError(e, "cannot use ... outside of a literal, a call or a destructuring pattern")
+	case *valueExpr:
+		return e.value, nil
 	default:
 		return nilValue, newError(e, ErrUnknownExpr)
 	}
 }
 
+func invokeDurationExpr(_ *VmParams, _ envPkg.IEnv, e *ast.DurationExpr) (reflect.Value, error) {
+	d, err := time.ParseDuration(e.Lit)
+	if err != nil {
+		return nilValue, newError(e, err)
+	}
+	return reflect.ValueOf(d), nil
+}
+
 func invokeNumberExpr(_ *VmParams, env envPkg.IEnv, e *ast.NumberExpr) (reflect.Value, error) {
 	nilValueL := nilValue
 	if strings.Contains(e.Lit, ".") || strings.Contains(e.Lit, "e") {
@@ -113,6 +140,20 @@ func invokeStringExpr(_ *VmParams, _ envPkg.IEnv, e *ast.StringExpr) (reflect.Va
 	return reflect.ValueOf(e.Lit), nil
 }
 
+func invokeInterpExpr(vmp *VmParams, env envPkg.IEnv, e *ast.InterpExpr) (reflect.Value, error) {
+	var sb strings.Builder
+	for i, expr := range e.Exprs {
+		sb.WriteString(e.Strs[i])
+		rv, err := invokeExpr(vmp, env, expr)
+		if err != nil {
+			return nilValue, newError(expr, err)
+		}
+		sb.WriteString(toString(rv))
+	}
+	sb.WriteString(e.Strs[len(e.Exprs)])
+	return reflect.ValueOf(sb.String()), nil
+}
+
 func makeType(vmp *VmParams, env envPkg.IEnv, typeStruct *ast.TypeStruct) (reflect.Type, error) {
 	switch typeStruct.Kind {
 	case ast.TypeDefault:
@@ -197,6 +238,12 @@ func makeType(vmp *VmParams, env envPkg.IEnv, typeStruct *ast.TypeStruct) (refle
 			if t == nil {
 				return nil, err
 			}
+			if !token.IsExported(typeStruct.StructNames[i]) {
+				return nil, fmt.Errorf("struct field '%s' must be exported", typeStruct.StructNames[i])
+			}
+			if slices.Contains(typeStruct.StructNames[:i], typeStruct.StructNames[i]) {
+				return nil, fmt.Errorf("duplicate struct field '%s'", typeStruct.StructNames[i])
+			}
 			fields = append(fields, reflect.StructField{Name: typeStruct.StructNames[i], Type: t})
 		}
 		// captures panic
@@ -224,14 +271,14 @@ func makeType(vmp *VmParams, env envPkg.IEnv, typeStruct *ast.TypeStruct) (refle
 //}
 
 func invokeArrayExpr(vmp *VmParams, env envPkg.IEnv, e *ast.ArrayExpr) (reflect.Value, error) {
+	var values []reflect.Value
+	if err := invokeElements(vmp, env, e.Exprs.Exprs, func(_ ast.Expr, rv reflect.Value) { values = append(values, rv) }); err != nil {
+		return nilValue, err
+	}
 	if e.TypeData == nil {
-		a := make([]any, len(e.Exprs.Exprs))
-		for i, expr := range e.Exprs.Exprs {
-			arg, err := invokeExpr(vmp, env, expr)
-			if err != nil {
-				return nilValue, newError(expr, err)
-			}
-			a[i] = arg.Interface()
+		a := make([]any, len(values))
+		for i, rv := range values {
+			a[i] = rv.Interface()
 		}
 		return reflect.ValueOf(a), nil
 	}
@@ -244,13 +291,9 @@ func invokeArrayExpr(vmp *VmParams, env envPkg.IEnv, e *ast.ArrayExpr) (reflect.
 		return nilValue, newStringError(e, "cannot make type nil")
 	}
 
-	slice := reflect.MakeSlice(t, len(e.Exprs.Exprs), len(e.Exprs.Exprs))
+	slice := reflect.MakeSlice(t, len(values), len(values))
 	valueType := t.Elem()
-	for i, ee := range e.Exprs.Exprs {
-		rv, err := invokeExpr(vmp, env, ee)
-		if err != nil {
-			return nilValue, err
-		}
+	for i, rv := range values {
 		rv, err = convertReflectValueToType(vmp, rv, valueType)
 		if err != nil {
 			return nilValue, newStringError(e, "cannot use type "+rv.Type().String()+" as type "+valueType.String()+" as slice value")
@@ -264,6 +307,12 @@ func invokeMapExpr(vmp *VmParams, env envPkg.IEnv, e *ast.MapExpr) (reflect.Valu
 	nilValueL := nilValue
 	m := make(map[any]any, len(e.Keys.Exprs))
 	for i, ee := range e.Keys.Exprs {
+		if spread, ok := ee.(*ast.SpreadExpr); ok {
+			if err := spreadMap(vmp, env, spread, m); err != nil {
+				return nilValueL, err
+			}
+			continue
+		}
 		key, err := invokeExpr(vmp, env, ee)
 		if err != nil {
 			return nilValueL, newError(ee, err)
@@ -278,6 +327,42 @@ func invokeMapExpr(vmp *VmParams, env envPkg.IEnv, e *ast.MapExpr) (reflect.Valu
 	return reflect.ValueOf(m), nil
 }
 
+func invokeStructExpr(vmp *VmParams, env envPkg.IEnv, e *ast.StructExpr) (reflect.Value, error) {
+	t, err := makeType(vmp, env, e.TypeData)
+	if err != nil {
+		return nilValue, newError(e, err)
+	}
+	if t.Kind() != reflect.Struct {
+		return nilValue, newStringError(e, "invalid struct literal type "+t.String())
+	}
+	if len(e.Names) == 0 && len(e.Values.Exprs) > 0 && len(e.Values.Exprs) != t.NumField() {
+		return nilValue, newStringError(e, fmt.Sprintf("invalid number of values in struct literal, have %d, expected: %d", len(e.Values.Exprs), t.NumField()))
+	}
+	v := reflect.New(t).Elem()
+	for i, expr := range e.Values.Exprs {
+		var field reflect.Value
+		if len(e.Names) > 0 {
+			structField, found := t.FieldByName(e.Names[i])
+			if !found {
+				return nilValue, newStringError(expr, "no member named '"+e.Names[i]+"' for struct")
+			}
+			field = v.FieldByIndex(structField.Index)
+		} else {
+			field = v.Field(i)
+		}
+		rv, err := invokeExpr(vmp, env, expr)
+		if err != nil {
+			return nilValue, newError(expr, err)
+		}
+		rv, err = convertReflectValueToType(vmp, rv, field.Type())
+		if err != nil {
+			return nilValue, newStringError(expr, "cannot use type "+rv.Type().String()+" as type "+field.Type().String()+" as struct value")
+		}
+		field.Set(rv)
+	}
+	return v, nil
+}
+
 func invokeDerefExpr(vmp *VmParams, env envPkg.IEnv, e *ast.DerefExpr) (reflect.Value, error) {
 	v := nilValue
 	switch ee := e.Expr.(type) {
@@ -318,6 +403,9 @@ func invokeDeferExprMemberExpr(vmp *VmParams, env envPkg.IEnv, e *ast.MemberExpr
 			if !m.IsValid() || err != nil {
 				return nilValue, invalidOperationErr
 			}
+			if !vme.IsVisible(e.Name) {
+				return nilValue, newUnexportedSymbol(e)
+			}
 			return m, nil
 		}
 	}
@@ -391,6 +479,9 @@ func invokeAddrExprMemberExpr(vmp *VmParams, env envPkg.IEnv, e *ast.MemberExpr)
 			if !m.IsValid() || err != nil {
 				return nilValue, invalidOperationErr
 			}
+			if !vme.IsVisible(memberExprName) {
+				return nilValue, newUnexportedSymbol(e)
+			}
 			return m, nil
 		}
 	}
@@ -435,6 +526,11 @@ func newInvalidOperation(e *ast.MemberExpr) error {
 	return newStringError(e, fmt.Sprintf("invalid operation '%s'", e.Name))
 }
 
+// newUnexportedSymbol returns the error of an access to a private symbol of a module
+func newUnexportedSymbol(e *ast.MemberExpr) error {
+	return newStringError(e, fmt.Sprintf("cannot refer to unexported symbol '%s'", e.Name))
+}
+
 func invokeMemberExpr(vmp *VmParams, env envPkg.IEnv, e *ast.MemberExpr) (reflect.Value, error) {
 	nilValueL := nilValue
 	v, err := invokeExpr(vmp, env, e.Expr)
@@ -442,6 +538,9 @@ func invokeMemberExpr(vmp *VmParams, env envPkg.IEnv, e *ast.MemberExpr) (reflec
 		return nilValueL, newError(e.Expr, err)
 	}
 	v = elemIfInterface(v)
+	if e.Optional && (!v.IsValid() || isNil(v)) {
+		return nilValueL, nil
+	}
 	if !v.IsValid() {
 		return nilValueL, newError(e, ErrNoSupportMemberOpInvalid)
 	}
@@ -449,10 +548,19 @@ func invokeMemberExpr(vmp *VmParams, env envPkg.IEnv, e *ast.MemberExpr) (reflec
 		if vme, ok := v.Interface().(envPkg.IEnv); ok {
 			m, err := vme.GetValue(e.Name)
 			if !m.IsValid() || err != nil {
+				if e.Optional {
+					return nilValueL, nil
+				}
 				return nilValueL, newInvalidOperation(e)
 			}
+			if !vme.IsVisible(e.Name) {
+				return nilValueL, newUnexportedSymbol(e)
+			}
 			return m, nil
 		}
+		if enum, ok := v.Interface().(*vmUtils.Enum); ok {
+			return invokeEnumMember(e, enum)
+		}
 	}
 
 	if method, found := v.Type().MethodByName(e.Name); found {
@@ -468,6 +576,9 @@ func invokeMemberExpr(vmp *VmParams, env envPkg.IEnv, e *ast.MemberExpr) (reflec
 		if found {
 			return v.FieldByIndex(field.Index), nil
 		}
+		if method, found := bindMethod(env, v, e.Name); found {
+			return method, nil
+		}
 		if v.CanAddr() {
 			v = v.Addr()
 			method, found := v.Type().MethodByName(e.Name)
@@ -485,6 +596,9 @@ func invokeMemberExpr(vmp *VmParams, env envPkg.IEnv, e *ast.MemberExpr) (reflec
 				return cv.Method(method.Index), nil
 			}
 		}
+		if e.Optional {
+			return nilValueL, nil
+		}
 		return nilValueL, newStringError(e, "no member named '"+e.Name+"' for struct")
 	case reflect.Map:
 		v = getMapIndex(vmp, reflect.ValueOf(e.Name), v)
@@ -509,6 +623,9 @@ func invokeItemExpr(vmp *VmParams, env envPkg.IEnv, e *ast.ItemExpr) (reflect.Va
 		return nilValue, newError(e.Index, err)
 	}
 	v = elemIfInterface(v)
+	if e.Optional && (!v.IsValid() || isNil(v)) {
+		return nilValue, nil
+	}
 	switch v.Kind() {
 	case reflect.String, reflect.Slice, reflect.Array:
 		ii, err := tryToInt(i)
@@ -517,6 +634,9 @@ func invokeItemExpr(vmp *VmParams, env envPkg.IEnv, e *ast.ItemExpr) (reflect.Va
 		}
 		if !vmp.Validate {
 			if ii < 0 || ii >= v.Len() {
+				if e.Optional {
+					return nilValue, nil
+				}
 				return nilValue, newError(e, ErrIndexOutOfRange)
 			}
 		}
@@ -632,6 +752,9 @@ func invokeAssocExpr(vmp *VmParams, env envPkg.IEnv, e *ast.AssocExpr) (reflect.
 		if err != nil {
 			return nilValue, newError(e, err)
 		}
+		if err := checkEnumOperands(e.Operator, v, nilValue); err != nil {
+			return nilValue, newError(e, err)
+		}
 		v = incrFn(v, incr)
 		// not checking err because checked above in get
 		_ = env.SetValue(id.Lit, v)
@@ -686,6 +809,11 @@ func invokeUnaryExpr(vmp *VmParams, env envPkg.IEnv, e *ast.UnaryExpr) (reflect.
 	if err != nil {
 		return nilValue, newError(e.Expr, err)
 	}
+	if e.Operator != "!" {
+		if err := checkEnumOperands(e.Operator, v, nilValue); err != nil {
+			return nilValue, newError(e, err)
+		}
+	}
 	switch e.Operator {
 	case "-":
 		if v.Kind() == reflect.Int64 {
@@ -732,6 +860,9 @@ func invokeBinOpExpr(vmp *VmParams, env envPkg.IEnv, e *ast.BinOpExpr, expr ast.
 		}
 		rhsV = elemIfInterfaceNNil(rhsV)
 	}
+	if err := checkEnumOperands(e.Operator, lhsV, rhsV); err != nil {
+		return nilValueL, newError(e, err)
+	}
 	switch e.Operator {
 	case "+":
 		if (lhsV.Kind() == reflect.Slice || lhsV.Kind() == reflect.Array) && (rhsV.Kind() != reflect.Slice && rhsV.Kind() != reflect.Array) {
@@ -1009,6 +1140,9 @@ func invokeChanExpr(vmp *VmParams, env envPkg.IEnv, e *ast.ChanExpr, expr ast.Ex
 }
 
 func invokeFuncExpr(vmp *VmParams, env envPkg.IEnv, e *ast.FuncExpr) (reflect.Value, error) {
+	if e.Receiver != nil {
+		return methodExpr(vmp, env, e)
+	}
 	return funcExpr(vmp, env, e)
 }
 
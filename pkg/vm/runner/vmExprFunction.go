@@ -67,13 +67,13 @@ func funcExpr(vmp *VmParams, env env.IEnv, funcExpr *ast.FuncExpr) (reflect.Valu
 				if isTyped {
 					rv = reflect.ValueOf(vmUtils.NewStronglyTyped(rv, funcExpr.Params[i].TypeData.Mutable))
 				}
-				err = newEnv.DefineValue(funcExpr.Params[i].Name, rv)
+				err = defineParam(vmp, newEnv, funcExpr.Params[i], rv)
 			} else {
-				tmp := reflect.ValueOf(inInterface)
+				tmp := addressableStruct(reflect.ValueOf(inInterface))
 				if isTyped {
 					tmp = reflect.ValueOf(vmUtils.NewStronglyTyped(tmp, funcExpr.Params[i].TypeData.Mutable))
 				}
-				err = newEnv.DefineValue(funcExpr.Params[i].Name, tmp)
+				err = defineParam(vmp, newEnv, funcExpr.Params[i], tmp)
 			}
 			if err != nil {
 				return []reflect.Value{reflect.ValueOf(nilValueL), reflect.ValueOf(reflect.ValueOf(newError(funcExpr, err)))}
@@ -84,7 +84,7 @@ func funcExpr(vmp *VmParams, env env.IEnv, funcExpr *ast.FuncExpr) (reflect.Valu
 			if funcExpr.VarArg {
 				// function is variadic, add last Params to newEnv without convert to Interface and then reflect.Value
 				rv = in[len(funcExpr.Params)]
-				err = newEnv.DefineValue(funcExpr.Params[len(funcExpr.Params)-1].Name, rv)
+				err = defineParam(vmp, newEnv, funcExpr.Params[len(funcExpr.Params)-1], rv)
 				if err != nil {
 					return []reflect.Value{reflect.ValueOf(nilValueL), reflect.ValueOf(reflect.ValueOf(newError(funcExpr, err)))}
 				}
@@ -99,36 +99,37 @@ func funcExpr(vmp *VmParams, env env.IEnv, funcExpr *ast.FuncExpr) (reflect.Valu
 					}
 					rv = newRv
 				} else {
-					rv = reflect.ValueOf(inInterface)
+					rv = addressableStruct(reflect.ValueOf(inInterface))
 					if isTyped {
 						rv = reflect.ValueOf(vmUtils.NewStronglyTyped(rv, funcExpr.Params[lenParams-1].TypeData.Mutable))
 					}
 				}
-				err = newEnv.DefineValue(funcExpr.Params[lenParams-1].Name, rv)
+				err = defineParam(vmp, newEnv, funcExpr.Params[lenParams-1], rv)
 				if err != nil {
 					return []reflect.Value{reflect.ValueOf(nilValueL), reflect.ValueOf(reflect.ValueOf(newError(funcExpr, err)))}
 				}
 			}
 		}
 
+		// the statements of a generator function are run by the loop iterating the generator
+		if funcExpr.Generator {
+			return []reflect.Value{reflect.ValueOf(reflect.ValueOf(&Generator{env: newEnv, funcExpr: funcExpr})), reflectValueErrorNilValue}
+		}
+
 		ctx := in[0].Interface().(*IsVmFunc)
 		// run function statements
 		newVmp := NewVmParams(ctx, vmp.rvCh, vmp.stats, vmp.protectMaps, vmp.mapMutex, vmp.pause, vmp.rateLimit, vmp.DbgEnabled, vmp.Validate, vmp.has, vmp.ValidateLater)
 		rv, err = runSingleStmt(newVmp, newEnv, funcExpr.Stmt)
 
-		for i := newEnv.Defers().Len() - 1; i >= 0; i-- {
-			cf := newEnv.Defers().Get(i)
-			if cf.CallSlice {
-				cf.Func.CallSlice(cf.Args)
-			} else {
-				cf.Func.Call(cf.Args)
-			}
-			newEnv.Defers().Remove(i)
-		}
+		runDefers(newEnv)
 		//env.defers = nil
 
 		if err != nil && !errors.Is(err, ErrReturn) {
 			err = newError(funcExpr, err)
+			var vmErr *Error
+			if errors.As(err, &vmErr) {
+				vmErr.addFrame(frameName(funcExpr))
+			}
 			// return nil value and error
 			// need to do single reflect.ValueOf because nilValue is already reflect.Value of nil
 			// need to do double reflect.ValueOf of newError in order to match
@@ -194,8 +195,8 @@ func funcExpr(vmp *VmParams, env env.IEnv, funcExpr *ast.FuncExpr) (reflect.Valu
 	// make the reflect.Value function that calls runVMFunction
 	rv := reflect.MakeFunc(funcType, runVMFunction)
 
-	// if function name is not empty, define it in the env
-	if funcExpr.Name != "" {
+	// if function name is not empty, define it in the env, the methods are defined by methodExpr
+	if funcExpr.Name != "" && funcExpr.Receiver == nil {
 		err := env.DefineValue(funcExpr.Name, rv)
 		if err != nil {
 			return nilValueL, newError(funcExpr, err)
@@ -203,7 +204,7 @@ func funcExpr(vmp *VmParams, env env.IEnv, funcExpr *ast.FuncExpr) (reflect.Valu
 	}
 
 	if vmp.Validate {
-		k := utils.Ternary(funcExpr.Name != "", funcExpr.Name, "invar_"+strconv.Itoa(rand.Intn(math.MaxInt32)))
+		k := utils.Ternary(funcExpr.Name != "", frameName(funcExpr), "invar_"+strconv.Itoa(rand.Intn(math.MaxInt32)))
 		vmp.ValidateLater[k] = funcExpr.Stmt
 	}
 
@@ -211,6 +212,99 @@ func funcExpr(vmp *VmParams, env env.IEnv, funcExpr *ast.FuncExpr) (reflect.Valu
 	return rv, nil
 }
 
+// runDefers calls the functions deferred in the env of a function, in the reverse order
+func runDefers(env env.IEnv) {
+	for i := env.Defers().Len() - 1; i >= 0; i-- {
+		cf := env.Defers().Get(i)
+		if cf.CallSlice {
+			cf.Func.CallSlice(cf.Args)
+		} else {
+			cf.Func.Call(cf.Args)
+		}
+		env.Defers().Remove(i)
+	}
+}
+
+// addressableStruct returns an addressable copy of a struct, for a function to be able to set the fields of its
+// parameters, such as a method those of its value receiver. Other values are returned as is.
+func addressableStruct(v reflect.Value) reflect.Value {
+	if v.Kind() != reflect.Struct || v.CanAddr() {
+		return v
+	}
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	return c
+}
+
+// frameName returns the name of the function in the call stacks, a method is prefixed by the type of its receiver
+func frameName(funcExpr *ast.FuncExpr) string {
+	if funcExpr.Name == "" {
+		return anonymousFrameName
+	}
+	if funcExpr.Receiver != nil {
+		return funcExpr.Receiver.TypeData.Name + "." + funcExpr.Name
+	}
+	return funcExpr.Name
+}
+
+// methodExpr handles *ast.FuncExpr with a receiver, which declares a method of a struct type.
+// The method is a function taking the receiver as first parameter, bindMethod binds it to a struct.
+func methodExpr(vmp *VmParams, env env.IEnv, e *ast.FuncExpr) (reflect.Value, error) {
+	recvType, err := makeType(vmp, env, e.Receiver.TypeData)
+	if err != nil {
+		return nilValue, newError(e, err)
+	}
+	structType := recvType
+	if structType.Kind() == reflect.Pointer {
+		structType = structType.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return nilValue, newStringError(e, "invalid receiver type "+recvType.String())
+	}
+	method := *e
+	method.Params = append([]*ast.ParamExpr{e.Receiver}, e.Params...)
+	fn, err := funcExpr(vmp, env, &method)
+	if err != nil {
+		return nilValue, err
+	}
+	if err := env.DefineMethod(structType, e.Name, fn); err != nil {
+		return nilValue, newError(e, err)
+	}
+	return fn, nil
+}
+
+// bindMethod returns the method declared by the script for the type of the struct, bound to the struct.
+// A method with a pointer receiver gets the address of the struct, or of a copy when it is not addressable.
+func bindMethod(env env.IEnv, v reflect.Value, name string) (reflect.Value, bool) {
+	fn, found := env.GetMethod(v.Type(), name)
+	if !found {
+		return nilValue, false
+	}
+	fnType := fn.Type()
+	recv := v
+	if fnType.In(1).Kind() == reflect.Pointer {
+		if v.CanAddr() {
+			recv = v.Addr()
+		} else {
+			recv = reflect.New(v.Type())
+			recv.Elem().Set(v)
+		}
+	}
+	inTypes := []reflect.Type{fnType.In(0)}
+	for i := 2; i < fnType.NumIn(); i++ {
+		inTypes = append(inTypes, fnType.In(i))
+	}
+	outTypes := []reflect.Type{fnType.Out(0), fnType.Out(1)}
+	boundType := reflect.FuncOf(inTypes, outTypes, fnType.IsVariadic())
+	return reflect.MakeFunc(boundType, func(in []reflect.Value) []reflect.Value {
+		args := append([]reflect.Value{in[0], recv}, in[1:]...)
+		if fnType.IsVariadic() {
+			return fn.CallSlice(args)
+		}
+		return fn.Call(args)
+	}), true
+}
+
 // anonCallExpr handles ast.AnonCallExpr which calls a function anonymously
 func anonCallExpr(vmp *VmParams, env env.IEnv, e *ast.AnonCallExpr) (reflect.Value, error) {
 	f, err := invokeExpr(vmp, env, e.Expr)
@@ -221,6 +315,7 @@ func anonCallExpr(vmp *VmParams, env env.IEnv, e *ast.AnonCallExpr) (reflect.Val
 	if f.Kind() == reflect.Func {
 		callExpr := &ast.CallExpr{Func: f, Callable: &ast.Callable{SubExprs: e.SubExprs, VarArg: e.VarArg, Go: e.Go, Defer: e.Defer}}
 		callExpr.SetPosition(e.Position())
+		callExpr.SetSpan(e.Span())
 		return invokeExpr(vmp, env, callExpr)
 	}
 	if !f.IsValid() {
@@ -271,6 +366,13 @@ func callExpr(vmp *VmParams, envArg env.IEnv, callExpr *ast.CallExpr) (rv reflec
 		return
 	}
 
+	// the spread arguments are expanded into the values of the lists they hold, ex: f(...a, 1, ...b)
+	if hasSpread(callExpr.SubExprs) {
+		if callExpr, err = spreadCallArgs(vmp, envArg, callExpr); err != nil {
+			return
+		}
+	}
+
 	var rvs []reflect.Value
 	var args []reflect.Value
 	var useCallSlice bool
@@ -283,11 +385,22 @@ func callExpr(vmp *VmParams, envArg env.IEnv, callExpr *ast.CallExpr) (rv reflec
 		return
 	}
 
+	// the errors of script functions record where they were called from, to build their stack
+	defer func() {
+		if err != nil {
+			setCallSite(err, callExpr)
+		}
+	}()
+
 	// capture panics if not in debug mode
 	if os.Getenv("ANKO_DEBUG") == "" {
 		defer func() {
 			if recoverResult := recover(); recoverResult != nil {
-				err = fmt.Errorf("%v", recoverResult)
+				if recoverErr, ok := recoverResult.(error); ok {
+					err = recoverErr // keeps the position of the errors of the scripts run by the function, such as load
+				} else {
+					err = fmt.Errorf("%v", recoverResult)
+				}
 			}
 		}()
 	}
@@ -0,0 +1,116 @@
+package runner
+
+import (
+	"errors"
+	"reflect"
+	"sync/atomic"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+	envPkg "github.com/alaingilbert/anko/pkg/vm/env"
+)
+
+// ErrGeneratorIterated is returned when a generator is iterated again
+var ErrGeneratorIterated = errors.New("generator already iterated")
+
+// errGeneratorStop is returned by the yield statements of a generator when the loop iterating it is over.
+// It unwinds the generator function, its finally blocks and deferred functions are run.
+var errGeneratorStop = errors.New("generator stopped")
+
+// Generator is returned by the call of a function having yield statements, it is iterated once by a for-in loop.
+// The function is run by the loop, each yield statement runs the body of the loop with its value.
+// No goroutine is involved, a loop ending early unwinds the function.
+type Generator struct {
+	env      envPkg.IEnv // env of the call, holding the arguments
+	funcExpr *ast.FuncExpr
+	started  atomic.Bool
+}
+
+// String returns the name of the generator function
+func (g *Generator) String() string {
+	return "generator " + frameName(g.funcExpr)
+}
+
+// toGenerator returns the generator held by the value, if it is one
+func toGenerator(v reflect.Value) (*Generator, bool) {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil, false
+	}
+	g, ok := v.Interface().(*Generator)
+	return g, ok
+}
+
+// iterate runs the generator function, yield is called with the value of each yield statement.
+// The function stops when yield returns errGeneratorStop.
+func (g *Generator) iterate(vmp *VmParams, yield func(reflect.Value) error) error {
+	if !g.started.CompareAndSwap(false, true) {
+		return ErrGeneratorIterated
+	}
+	genVmp := NewVmParams(vmp.ctx, vmp.rvCh, vmp.stats, vmp.protectMaps, vmp.mapMutex, vmp.pause, vmp.rateLimit, vmp.DbgEnabled, vmp.Validate, vmp.has, vmp.ValidateLater)
+	genVmp.yield = yield
+	_, err := runSingleStmt(genVmp, g.env, g.funcExpr.Stmt)
+	runDefers(g.env)
+	if err == nil || errors.Is(err, ErrReturn) || errors.Is(err, errGeneratorStop) {
+		return nil
+	}
+	err = newError(g.funcExpr, err)
+	var vmErr *Error
+	if errors.As(err, &vmErr) {
+		vmErr.addFrame(frameName(g.funcExpr))
+	}
+	return err
+}
+
+func runYieldStmt(vmp *VmParams, env envPkg.IEnv, stmt *ast.YieldStmt) (reflect.Value, error) {
+	rv, err := invokeExpr(vmp, env, stmt.Expr)
+	if err != nil {
+		return rv, newError(stmt.Expr, err)
+	}
+	if vmp.yield == nil {
+		if vmp.Validate {
+			return nilValue, nil // the body of a generator function validated on its own
+		}
+		return nilValue, newStringError(stmt, "yield outside of a generator")
+	}
+	return nilValue, vmp.yield(rv)
+}
+
+// runForStmtGenerator runs the body of the for-in loop with each value yielded by the generator.
+// The errors of the body, its break and return stop the generator, then are handled once it is unwound.
+func runForStmtGenerator(vmp *VmParams, env envPkg.IEnv, stmt *ast.ForStmt, g *Generator) (reflect.Value, error) {
+	newenv := env.NewEnv()
+	defer newenv.Destroy()
+	rv, err := nilValue, error(nil)
+	stopped, returned := false, false
+	genErr := g.iterate(vmp, func(v reflect.Value) error {
+		if stopped {
+			return errGeneratorStop
+		}
+		if err = incrCycle(vmp); err != nil {
+			rv, stopped, returned = nilValue, true, true
+			return errGeneratorStop
+		}
+		if defErr := defineForVars(vmp, newenv, stmt, elemIfInterface(v)); defErr != nil {
+			rv, stopped, returned, err = nilValue, true, true, newError(stmt, defErr)
+			return errGeneratorStop
+		}
+		var bodyErr error
+		rv, bodyErr = runSingleStmt(vmp, newenv, stmt.Stmt)
+		herr := handleStmtErr(vmp, stmt, bodyErr, true)
+		if errors.Is(herr, errLoopBreak) {
+			stopped = true
+		} else if errors.Is(herr, errLoopReturn) {
+			stopped, returned, err = true, true, bodyErr
+		}
+		if stopped {
+			return errGeneratorStop
+		}
+		return nil
+	})
+	if returned {
+		return rv, err
+	}
+	if genErr != nil {
+		return nilValue, newError(stmt, genErr)
+	}
+	return nilValue, nil
+}
@@ -10,6 +10,9 @@ import (
 )
 
 func invokeLetExpr(vmp *VmParams, env envPkg.IEnv, stmt *ast.LetsStmt, expr ast.Expr, rv reflect.Value) (reflect.Value, error) {
+	if isOptionalChain(expr) {
+		return nilValue, newStringError(expr, "cannot assign to an optional chaining expression")
+	}
 	switch lhs := expr.(type) {
 	case *ast.IdentExpr:
 		return invokeLetIdentExpr(env, rv, stmt, lhs)
@@ -21,10 +24,28 @@ func invokeLetExpr(vmp *VmParams, env envPkg.IEnv, stmt *ast.LetsStmt, expr ast.
 		return invokeLetSliceExpr(vmp, env, rv, lhs)
 	case *ast.DerefExpr:
 		return invokeLetDerefExpr(vmp, env, rv, stmt, lhs)
+	case *ast.ArrayExpr, *ast.MapExpr:
+		return rv, destructure(vmp, env, lhs, rv, func(target ast.Expr, v reflect.Value) error {
+			if _, err := invokeLetExpr(vmp, env, stmt, target, v); err != nil {
+				return newError(target, err)
+			}
+			return nil
+		})
 	}
 	return nilValue, newError(expr, ErrInvalidOperation)
 }
 
+// isOptionalChain returns true if the expression is a member or an item access of optional chaining, a?.b or a?[i]
+func isOptionalChain(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.MemberExpr:
+		return e.Optional
+	case *ast.ItemExpr:
+		return e.Optional
+	}
+	return false
+}
+
 func invokeLetIdentExpr(env envPkg.IEnv, rv reflect.Value, stmt *ast.LetsStmt, lhs *ast.IdentExpr) (vv reflect.Value, err error) {
 	stmtTyped := stmt.Typed
 	if env.HasValue(lhs.Lit) && stmtTyped {
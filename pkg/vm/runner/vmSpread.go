@@ -0,0 +1,103 @@
+package runner
+
+import (
+	"reflect"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+	envPkg "github.com/alaingilbert/anko/pkg/vm/env"
+)
+
+// valueExpr is an expression of a value already evaluated, such as an argument expanded from a spread.
+type valueExpr struct {
+	ast.ExprImpl
+	value reflect.Value
+}
+
+// invokeElements evaluates the elements of a list literal or the arguments of a call, calling add with each
+// expression and its value. The spread ones, ...a, are expanded into the values of the list they hold.
+func invokeElements(vmp *VmParams, env envPkg.IEnv, exprs []ast.Expr, add func(ast.Expr, reflect.Value)) error {
+	for _, expr := range exprs {
+		spread, ok := expr.(*ast.SpreadExpr)
+		if !ok {
+			rv, err := invokeExpr(vmp, env, expr)
+			if err != nil {
+				return newError(expr, err)
+			}
+			add(expr, rv)
+			continue
+		}
+		rv, err := invokeExpr(vmp, env, spread.Expr)
+		if err != nil {
+			return newError(spread.Expr, err)
+		}
+		rv = elemIfInterfaceNNil(rv)
+		if isNil(rv) {
+			continue // spreading nil gives no value
+		}
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return newStringError(spread, "cannot spread type "+rv.Type().String()+" into a list")
+		}
+		for i := 0; i < rv.Len(); i++ {
+			add(spread, rv.Index(i))
+		}
+	}
+	return nil
+}
+
+// spreadMap copies the entries of the map held by a spread into m.
+func spreadMap(vmp *VmParams, env envPkg.IEnv, spread *ast.SpreadExpr, m map[any]any) error {
+	rv, err := invokeExpr(vmp, env, spread.Expr)
+	if err != nil {
+		return newError(spread.Expr, err)
+	}
+	rv = elemIfInterfaceNNil(rv)
+	if isNil(rv) {
+		return nil
+	}
+	if rv.Kind() != reflect.Map {
+		return newStringError(spread, "cannot spread type "+rv.Type().String()+" into a map")
+	}
+	for _, key := range rv.MapKeys() {
+		m[key.Interface()] = readMapIndex(rv, key, vmp).Interface()
+	}
+	return nil
+}
+
+// hasSpread returns true if one of the expressions is a spread.
+func hasSpread(exprs *ast.ExprsExpr) bool {
+	if exprs == nil {
+		return false
+	}
+	for _, expr := range exprs.Exprs {
+		if _, ok := expr.(*ast.SpreadExpr); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// spreadCallArgs returns a copy of the call with its arguments evaluated, the spread ones being expanded into the
+// values of the list they hold, ex: f(...a, 1, ...b). The last argument of a variadic call is spread too, f(...a, b...).
+func spreadCallArgs(vmp *VmParams, env envPkg.IEnv, e *ast.CallExpr) (*ast.CallExpr, error) {
+	subExprs := e.SubExprs.Exprs
+	if e.VarArg {
+		last := &ast.SpreadExpr{Expr: subExprs[len(subExprs)-1]}
+		last.SetPosition(last.Expr.Position())
+		subExprs = append(subExprs[:len(subExprs)-1:len(subExprs)-1], last)
+	}
+	exprs := make([]ast.Expr, 0, len(subExprs))
+	err := invokeElements(vmp, env, subExprs, func(src ast.Expr, rv reflect.Value) {
+		arg := &valueExpr{value: rv}
+		arg.SetPosition(src.Position())
+		exprs = append(exprs, arg)
+	})
+	if err != nil {
+		return nil, err
+	}
+	callable := *e.Callable
+	callable.SubExprs = &ast.ExprsExpr{Exprs: exprs}
+	callable.VarArg = false
+	out := *e
+	out.Callable = &callable
+	return &out, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/alaingilbert/anko/pkg/ast"
 	envPkg "github.com/alaingilbert/anko/pkg/vm/env"
 	vmUtils "github.com/alaingilbert/anko/pkg/vm/utils"
+	"os"
 	"reflect"
 	"strconv"
 )
@@ -44,6 +45,8 @@ func runSingleStmt(vmp *VmParams, env envPkg.IEnv, stmt ast.Stmt) (reflect.Value
 		return runReturnStmt(vmp, env, stmt)
 	case *ast.ThrowStmt:
 		return runThrowStmt(vmp, env, stmt)
+	case *ast.YieldStmt:
+		return runYieldStmt(vmp, env, stmt)
 	case *ast.ModuleStmt:
 		return runModuleStmt(vmp, env, stmt)
 	case *ast.SelectStmt:
@@ -54,10 +57,22 @@ func runSingleStmt(vmp *VmParams, env envPkg.IEnv, stmt ast.Stmt) (reflect.Value
 		return runGoroutineStmt(vmp, env, stmt)
 	case *ast.DeferStmt:
 		return runDeferStmt(vmp, env, stmt)
+	case *ast.WithStmt:
+		return runWithStmt(vmp, env, stmt)
 	case *ast.DbgStmt:
 		return invokeDbgStmt(vmp, env, stmt)
 	case *ast.LabelStmt:
 		return invokeLabelStmt(vmp, env, stmt)
+	case *ast.TypeStmt:
+		return runTypeStmt(vmp, env, stmt)
+	case *ast.EnumStmt:
+		return runEnumStmt(env, stmt)
+	case *ast.ExportStmt:
+		return runExportStmt(vmp, env, stmt)
+	case *ast.ImportStmt:
+		return runImportStmt(vmp, env, stmt)
+	case *comprehensionStmt:
+		return runComprehensionStmt(vmp, env, stmt)
 	default:
 		return nilValue, newError(stmt, ErrUnknownStmt)
 	}
@@ -214,6 +229,16 @@ func runVarOrLetStmt[K any](vmp *VmParams, env envPkg.IEnv, exprs1 []K, exprs2 [
 }
 
 func runVarStmt(vmp *VmParams, env envPkg.IEnv, stmt *ast.VarStmt) (reflect.Value, error) {
+	if stmt.Pattern != nil {
+		rv, err := invokeExpr(vmp, env, stmt.Exprs[0])
+		if err != nil {
+			return nilValue, newError(stmt.Exprs[0], err)
+		}
+		if err := definePattern(vmp, env, stmt.Pattern, rv); err != nil {
+			return nilValue, newError(stmt, err)
+		}
+		return rv, nil
+	}
 	defineFn := func(key string, v reflect.Value) error {
 		if err := env.DefineValue(key, v); err != nil {
 			return newError(stmt, err)
@@ -306,18 +331,10 @@ func runTryStmt(vmp *VmParams, env envPkg.IEnv, stmt *ast.TryStmt) (reflect.Valu
 	newenv := env.NewEnv()
 	defer newenv.Destroy()
 	_, err := runSingleStmt(vmp, newenv, stmt.Try)
-	if err != nil || validate {
-		// Catch
-		env.WithNewEnv(func(catchEnv envPkg.IEnv) {
-			if stmt.Var != "" {
-				_ = catchEnv.DefineValue(stmt.Var, reflect.ValueOf(err))
-			}
-			if _, catchErr := runSingleStmt(vmp, catchEnv, stmt.Catch); catchErr != nil {
-				err = newError(stmt.Catch, catchErr)
-			} else {
-				err = nil
-			}
-		})
+	if validate {
+		err = validateCatches(vmp, env, stmt)
+	} else if err != nil && !errors.Is(err, errGeneratorStop) {
+		err = runCatch(vmp, env, stmt, err)
 	}
 	if stmt.Finally != nil {
 		// Finally
@@ -332,6 +349,56 @@ func runTryStmt(vmp *VmParams, env envPkg.IEnv, stmt *ast.TryStmt) (reflect.Valu
 	return nilValue, nil
 }
 
+// runCatch runs the first catch clause of the try statement matching the error, the error is returned if there is none
+func runCatch(vmp *VmParams, env envPkg.IEnv, stmt *ast.TryStmt, err error) error {
+	for _, clause := range stmt.Catches {
+		for _, kind := range clause.Kinds {
+			rv, kindErr := invokeExpr(vmp, env, kind)
+			if kindErr != nil {
+				return newError(kind, kindErr)
+			}
+			if rv.IsValid() && rv.CanInterface() && errorIs(err, rv.Interface()) {
+				return runCatchBlock(vmp, env, clause.Var, clause.Stmt, err)
+			}
+		}
+	}
+	if stmt.Catch == nil && len(stmt.Catches) > 0 {
+		return err
+	}
+	return runCatchBlock(vmp, env, stmt.Var, stmt.Catch, err)
+}
+
+// validateCatches validates the kinds and the blocks of all the catch clauses of the try statement
+func validateCatches(vmp *VmParams, env envPkg.IEnv, stmt *ast.TryStmt) error {
+	for _, clause := range stmt.Catches {
+		for _, kind := range clause.Kinds {
+			if _, err := invokeExpr(vmp, env, kind); err != nil {
+				return newError(kind, err)
+			}
+		}
+		if err := runCatchBlock(vmp, env, clause.Var, clause.Stmt, nil); err != nil {
+			return err
+		}
+	}
+	if stmt.Catch == nil && len(stmt.Catches) > 0 {
+		return nil
+	}
+	return runCatchBlock(vmp, env, stmt.Var, stmt.Catch, nil)
+}
+
+// runCatchBlock runs the block of a catch clause, in a new env where the error is defined with the given name
+func runCatchBlock(vmp *VmParams, env envPkg.IEnv, name string, block ast.Stmt, err error) (out error) {
+	env.WithNewEnv(func(catchEnv envPkg.IEnv) {
+		if name != "" {
+			_ = catchEnv.DefineValue(name, reflect.ValueOf(err))
+		}
+		if _, catchErr := runSingleStmt(vmp, catchEnv, block); catchErr != nil {
+			out = newError(block, catchErr)
+		}
+	})
+	return out
+}
+
 var (
 	errLoopContinue = errors.New("continue")
 	errLoopBreak    = errors.New("break")
@@ -407,6 +474,9 @@ func runForStmt(vmp *VmParams, env envPkg.IEnv, stmt *ast.ForStmt) (reflect.Valu
 		return val, ee
 	}
 	val = elemIfInterfaceNNil(val)
+	if g, ok := toGenerator(val); ok {
+		return runForStmtGenerator(vmp, env, stmt, g)
+	}
 	switch val.Kind() {
 	case reflect.Slice, reflect.Array:
 		return runForStmtSlice(vmp, env, stmt, val)
@@ -414,6 +484,11 @@ func runForStmt(vmp *VmParams, env envPkg.IEnv, stmt *ast.ForStmt) (reflect.Valu
 		return runForStmtMap(vmp, env, stmt, val)
 	case reflect.Chan:
 		return runForStmtChan(vmp, env, stmt, val)
+	case reflect.Func:
+		if isSeqFunc(val.Type()) {
+			return runForStmtSeq(vmp, env, stmt, val)
+		}
+		return nilValue, newStringError(stmt, "for cannot loop over type "+val.Type().String())
 	default:
 		return nilValue, newStringError(stmt, "for cannot loop over type "+val.Kind().String())
 	}
@@ -429,7 +504,9 @@ func runForStmtSlice(vmp *VmParams, env envPkg.IEnv, stmt *ast.ForStmt, val refl
 		}
 		iv := val.Index(i)
 		iv = elemIfInterface(iv)
-		_ = newenv.DefineValue(stmt.Vars[0], iv)
+		if err := defineForVars(vmp, newenv, stmt, iv); err != nil {
+			return nilValueL, newError(stmt, err)
+		}
 		rv, err := runSingleStmt(vmp, newenv, stmt.Stmt)
 		herr := handleStmtErr(vmp, stmt, err, true)
 		if errors.Is(herr, errLoopContinue) {
@@ -452,10 +529,12 @@ func runForStmtMap(vmp *VmParams, env envPkg.IEnv, stmt *ast.ForStmt, val reflec
 		if err := incrCycle(vmp); err != nil {
 			return nilValueL, err
 		}
-		_ = newenv.DefineValue(stmt.Vars[0], keys[i])
+		values := []reflect.Value{keys[i]}
 		if len(stmt.Vars) > 1 {
-			m := readMapIndex(val, keys[i], vmp)
-			_ = newenv.DefineValue(stmt.Vars[1], m)
+			values = append(values, readMapIndex(val, keys[i], vmp))
+		}
+		if err := defineForVars(vmp, newenv, stmt, values...); err != nil {
+			return nilValueL, newError(stmt, err)
 		}
 		rv, err := runSingleStmt(vmp, newenv, stmt.Stmt)
 		herr := handleStmtErr(vmp, stmt, err, true)
@@ -490,7 +569,9 @@ func runForStmtChan(vmp *VmParams, env envPkg.IEnv, stmt *ast.ForStmt, val refle
 			}
 		}
 		iv = elemIfInterface(iv)
-		_ = newenv.DefineValue(stmt.Vars[0], iv)
+		if err := defineForVars(vmp, newenv, stmt, iv); err != nil {
+			return nilValue, newError(stmt, err)
+		}
 		rv, err := runSingleStmt(vmp, newenv, stmt.Stmt)
 		herr := handleStmtErr(vmp, stmt, err, true)
 		if errors.Is(herr, errLoopContinue) {
@@ -504,6 +585,87 @@ func runForStmtChan(vmp *VmParams, env envPkg.IEnv, stmt *ast.ForStmt, val refle
 	return nilValue, nil
 }
 
+// isSeqFunc returns true if the type is the one of a range-over-func iterator, such as iter.Seq or iter.Seq2:
+// func(yield func(V) bool) or func(yield func(K, V) bool)
+func isSeqFunc(t reflect.Type) bool {
+	if t.NumIn() != 1 || t.NumOut() != 0 || t.IsVariadic() {
+		return false
+	}
+	yield := t.In(0)
+	return yield.Kind() == reflect.Func && !yield.IsVariadic() && (yield.NumIn() == 1 || yield.NumIn() == 2) &&
+		yield.NumOut() == 1 && yield.Out(0).Kind() == reflect.Bool
+}
+
+// runForStmtSeq runs the body of the for-in loop with each value given by a range-over-func iterator.
+// The yield function returns false to the iterator when the loop is over, before the error of the body is handled.
+func runForStmtSeq(vmp *VmParams, env envPkg.IEnv, stmt *ast.ForStmt, val reflect.Value) (rv reflect.Value, err error) {
+	if val.IsNil() {
+		return nilValue, newStringError(stmt, "for cannot loop over nil function")
+	}
+	newenv := env.NewEnv()
+	defer newenv.Destroy()
+	yieldType := val.Type().In(0)
+	defineVars := func(args []reflect.Value) error {
+		values := make([]reflect.Value, len(args))
+		for i, arg := range args {
+			values[i] = elemIfInterface(arg)
+		}
+		return defineForVars(vmp, newenv, stmt, values...)
+	}
+	if vmp.Validate {
+		args := make([]reflect.Value, yieldType.NumIn())
+		for i := range args {
+			args[i] = zeroOfType(yieldType.In(i))
+		}
+		if err := defineVars(args); err != nil {
+			return nilValue, newError(stmt, err)
+		}
+		_, err := runSingleStmt(vmp, newenv, stmt.Stmt)
+		if herr := handleStmtErr(vmp, stmt, err, true); errors.Is(herr, errLoopReturn) {
+			return nilValue, err
+		}
+		return nilValue, nil
+	}
+	rv = nilValue
+	stopped, returned := false, false
+	more := func() []reflect.Value { return []reflect.Value{reflect.ValueOf(!stopped).Convert(yieldType.Out(0))} }
+	yield := reflect.MakeFunc(yieldType, func(args []reflect.Value) []reflect.Value {
+		if stopped {
+			return more()
+		}
+		if err = incrCycle(vmp); err != nil {
+			rv, stopped, returned = nilValue, true, true
+			return more()
+		}
+		if defErr := defineVars(args); defErr != nil {
+			rv, stopped, returned, err = nilValue, true, true, newError(stmt, defErr)
+			return more()
+		}
+		var bodyErr error
+		rv, bodyErr = runSingleStmt(vmp, newenv, stmt.Stmt)
+		herr := handleStmtErr(vmp, stmt, bodyErr, true)
+		if errors.Is(herr, errLoopBreak) {
+			stopped = true
+		} else if errors.Is(herr, errLoopReturn) {
+			stopped, returned, err = true, true, bodyErr
+		}
+		return more()
+	})
+	// capture the panics of the iterator if not in debug mode
+	if os.Getenv("ANKO_DEBUG") == "" {
+		defer func() {
+			if recoverResult := recover(); recoverResult != nil {
+				rv, err = nilValue, newErrorf(stmt, "%v", recoverResult)
+			}
+		}()
+	}
+	val.Call([]reflect.Value{yield})
+	if returned {
+		return rv, err
+	}
+	return nilValue, nil
+}
+
 func runCForStmt(vmp *VmParams, env envPkg.IEnv, stmt *ast.CForStmt) (reflect.Value, error) {
 	nilValueL := nilValue
 	newenv := env.NewEnv()
@@ -578,11 +740,16 @@ func runThrowStmt(vmp *VmParams, env envPkg.IEnv, stmt *ast.ThrowStmt) (reflect.
 	if !rv.IsValid() {
 		return nilValue, newStringError(stmt, "invalid type")
 	}
-	return rv, newStringError(stmt, fmt.Sprint(rv.Interface()))
+	if v := elemIfInterface(rv); v.IsValid() && v.CanInterface() {
+		if err, ok := v.Interface().(error); ok {
+			return rv, newError(stmt, err) // the error is kept, for the host and the catch clauses to match it
+		}
+	}
+	return rv, newError(stmt, newThrownError(rv))
 }
 
 func runModuleStmt(vmp *VmParams, env envPkg.IEnv, stmt *ast.ModuleStmt) (reflect.Value, error) {
-	newenv := env.NewEnv()
+	newenv := env.NewModuleEnv()
 	defer newenv.Destroy()
 	rv, err := runSingleStmt(vmp, newenv, stmt.Stmt)
 	if err != nil {
@@ -592,6 +759,54 @@ func runModuleStmt(vmp *VmParams, env envPkg.IEnv, stmt *ast.ModuleStmt) (reflec
 	return rv, nil
 }
 
+// runExportStmt runs the declaration of the exported symbols, then makes them visible from outside of the module
+func runExportStmt(vmp *VmParams, env envPkg.IEnv, stmt *ast.ExportStmt) (reflect.Value, error) {
+	rv, err := runSingleStmt(vmp, env, stmt.Stmt)
+	if err != nil {
+		return rv, newError(stmt, err)
+	}
+	for _, name := range stmt.Names() {
+		env.Export(name)
+	}
+	return rv, nil
+}
+
+// runImportStmt imports a script module, its env is defined with the given name like the one of a module statement
+func runImportStmt(vmp *VmParams, env envPkg.IEnv, stmt *ast.ImportStmt) (reflect.Value, error) {
+	module, err := importModule(vmp.ctx, stmt.Path)
+	if err != nil {
+		setModuleFrame(err)
+		setCallSite(err, stmt)
+		return nilValue, newError(stmt, err)
+	}
+	rv := reflect.ValueOf(module)
+	if err := env.DefineValue(stmt.Name, rv); err != nil {
+		return nilValue, newError(stmt, err)
+	}
+	return rv, nil
+}
+
+// runTypeStmt defines the type declared by the script in the current scope.
+// The fields of a declared struct are tagged with its name, for two declarations to give distinct types.
+func runTypeStmt(vmp *VmParams, env envPkg.IEnv, stmt *ast.TypeStmt) (reflect.Value, error) {
+	t, err := makeType(vmp, env, stmt.TypeData)
+	if err != nil {
+		return nilValue, newError(stmt, err)
+	}
+	if stmt.TypeData.Kind == ast.TypeStructType {
+		fields := make([]reflect.StructField, t.NumField())
+		for i := range fields {
+			fields[i] = t.Field(i)
+			fields[i].Tag = reflect.StructTag(`anko:"` + stmt.Name + `"`)
+		}
+		t = reflect.StructOf(fields)
+	}
+	if err := env.DefineReflectType(stmt.Name, t); err != nil {
+		return nilValue, newError(stmt, err)
+	}
+	return nilValue, nil
+}
+
 var ErrInvalidOperation = errors.New("invalid operation")
 var ErrInvalidOperationForTheValue = errors.New("invalid operation for the value")
 
@@ -696,6 +911,7 @@ func runSwitchStmt(vmp *VmParams, env envPkg.IEnv, stmt *ast.SwitchStmt) (reflec
 		return rv, newError(stmt, err)
 	}
 
+	var caseValues []reflect.Value
 	for _, switchCaseStmt := range stmt.Cases {
 		caseStmt := switchCaseStmt.(*ast.SwitchCaseStmt)
 		for _, expr := range caseStmt.Exprs.Exprs {
@@ -703,6 +919,9 @@ func runSwitchStmt(vmp *VmParams, env envPkg.IEnv, stmt *ast.SwitchStmt) (reflec
 			if err != nil {
 				return nilValue, newError(expr, err)
 			}
+			if validate {
+				caseValues = append(caseValues, caseValue)
+			}
 			if equal(rv, caseValue) || validate {
 				val, err := runSingleStmt(vmp, newenv, caseStmt.Stmt)
 				if err != nil {
@@ -714,6 +933,9 @@ func runSwitchStmt(vmp *VmParams, env envPkg.IEnv, stmt *ast.SwitchStmt) (reflec
 			}
 		}
 	}
+	if validate {
+		checkSwitchCases(vmp, stmt, rv, caseValues)
+	}
 
 	if stmt.Default != nil {
 		rv, err = runSingleStmt(vmp, newenv, stmt.Default)
@@ -767,6 +989,12 @@ func runDeferStmtMakeDefer(vmp *VmParams, f reflect.Value, env envPkg.IEnv, call
 		injectCtx = true
 		f = reflect.ValueOf(val.Value)
 	}
+	if hasSpread(callExprInst.SubExprs) {
+		var err error
+		if callExprInst, err = spreadCallArgs(vmp, env, callExprInst); err != nil {
+			return f, err
+		}
+	}
 	fType := f.Type()
 	isRunVmFunction := checkIfRunVMFunction(fType)
 	args, _, useCallSlice, err := makeCallArgs(vmp, env, fType, isRunVmFunction, callExprInst, injectCtx)
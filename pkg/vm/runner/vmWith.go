@@ -0,0 +1,67 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/alaingilbert/anko/pkg/ast"
+	envPkg "github.com/alaingilbert/anko/pkg/vm/env"
+)
+
+// runWithStmt runs the block of a with statement with a child of the context of the script, ex: with timeout(500ms) {}
+// or with cancel(ch) {}. Everything run by the block uses that context, the calls of the functions defined with
+// DefineCtx included. When it is done before the end of the block, the error of the block is ErrTimeout, or ErrCanceled.
+func runWithStmt(vmp *VmParams, env envPkg.IEnv, stmt *ast.WithStmt) (reflect.Value, error) {
+	rv, err := invokeExpr(vmp, env, stmt.Expr)
+	if err != nil {
+		return nilValue, newError(stmt.Expr, err)
+	}
+	ctx, cancel := context.WithCancel(vmp.ctx)
+	defer cancel()
+	switch stmt.Kind {
+	case ast.WithTimeout:
+		d, ok := toDuration(rv)
+		if !ok && !vmp.Validate {
+			return nilValue, newStringError(stmt.Expr, "timeout must be a duration, not "+kindOrType(rv))
+		}
+		if !vmp.Validate {
+			ctx, cancel = context.WithTimeout(ctx, d)
+			defer cancel()
+		}
+	case ast.WithCancel:
+		ch := elemIfInterfaceNNil(rv)
+		if !ch.IsValid() || ch.Kind() != reflect.Chan || ch.Type().ChanDir()&reflect.RecvDir == 0 {
+			if !vmp.Validate {
+				return nilValue, newStringError(stmt.Expr, "cancel needs a channel, not "+kindOrType(rv))
+			}
+		} else if !vmp.Validate {
+			go cancelOnReceive(ctx, cancel, ch)
+		}
+	}
+	withVmp := NewVmParams(ctx, vmp.rvCh, vmp.stats, vmp.protectMaps, vmp.mapMutex, vmp.pause, vmp.rateLimit, vmp.DbgEnabled, vmp.Validate, vmp.has, vmp.ValidateLater)
+	withVmp.yield = vmp.yield
+	newenv := env.NewEnv()
+	defer newenv.Destroy()
+	rv, err = runSingleStmt(withVmp, newenv, stmt.Stmt)
+	if ctx.Err() != nil && vmp.ctx.Err() == nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nilValue, newError(stmt, ErrTimeout)
+		}
+		return nilValue, newError(stmt, ErrCanceled)
+	}
+	if err != nil {
+		return rv, newError(stmt, err)
+	}
+	return rv, nil
+}
+
+// cancelOnReceive cancels the context when a value is received from the channel, or when it is closed
+func cancelOnReceive(ctx context.Context, cancel context.CancelFunc, ch reflect.Value) {
+	chosen, _, _ := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())},
+		{Dir: reflect.SelectRecv, Chan: ch}})
+	if chosen == 1 {
+		cancel()
+	}
+}
@@ -0,0 +1,44 @@
+package utils
+
+// Enum is an enum declared by a script, ex: enum Color { Red, Green, Blue }. Its values are its members, ex: Color.Red
+type Enum struct {
+	Name  string
+	Names []string
+}
+
+// NewEnum returns an enum with the names of its values, in order
+func NewEnum(name string, names []string) *Enum {
+	return &Enum{Name: name, Names: names}
+}
+
+// Value returns the value of the enum with the given name
+func (e *Enum) Value(name string) (EnumValue, bool) {
+	for i, n := range e.Names {
+		if n == name {
+			return EnumValue{Enum: e, Index: i}, true
+		}
+	}
+	return EnumValue{}, false
+}
+
+// String returns the name of the enum
+func (e *Enum) String() string {
+	return e.Name
+}
+
+// EnumValue is a value of an enum. Its type is distinct from the one of the strings and the numbers,
+// it is only equal to itself.
+type EnumValue struct {
+	Enum  *Enum
+	Index int
+}
+
+// String returns the name of the value, ex: Red
+func (v EnumValue) String() string {
+	return v.Enum.Names[v.Index]
+}
+
+// GoString returns the name of the value with the one of its enum, ex: Color.Red
+func (v EnumValue) GoString() string {
+	return v.Enum.Name + "." + v.String()
+}
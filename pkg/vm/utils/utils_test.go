@@ -8,6 +8,7 @@ import (
 func TestFormatValue(t *testing.T) {
 	f1 := func() any { return nil }
 	f2 := func(any, any) (any, any) { return nil, nil }
+	red, _ := NewEnum("Color", []string{"Red", "Green"}).Value("Red")
 	type args struct {
 		value reflect.Value
 	}
@@ -20,6 +21,8 @@ func TestFormatValue(t *testing.T) {
 		{"", args{reflect.ValueOf(f1)}, "func() any"},
 		{"", args{reflect.ValueOf(f2)}, "func(any, any) (any, any)"},
 		{"", args{reflect.ValueOf(nil)}, "<nil>"},
+		{"", args{reflect.ValueOf(red)}, "Color.Red"},
+		{"", args{reflect.ValueOf([]any{red})}, "[]any{Color.Red}"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -37,6 +37,8 @@ type Config struct {
 	Watchdog        *bool
 	MaxEnvCount     *int
 	ResetEnv        *bool
+	Optimize        *bool
+	ImportPaths     []string // directories searched for the script modules imported with a path not starting with ./ or ../
 }
 
 // VM base vm
@@ -52,6 +54,8 @@ type VM struct {
 	watchdog        *bool
 	maxEnvCount     *int
 	resetEnv        *bool
+	optimize        *bool
+	importPaths     []string
 }
 
 // New creates a new vm
@@ -74,6 +78,8 @@ func New(config *Config) *VM {
 		v.watchdog = config.Watchdog
 		v.maxEnvCount = config.MaxEnvCount
 		v.resetEnv = config.ResetEnv
+		v.optimize = config.Optimize
+		v.importPaths = config.ImportPaths
 	}
 	return v
 }
@@ -127,6 +133,8 @@ func (v *VM) getDefaultExecutorConfig() *executor.Config {
 		Watchdog:        v.watchdog,
 		MaxEnvCount:     v.maxEnvCount,
 		ResetEnv:        v.resetEnv,
+		Optimize:        v.optimize,
+		ImportPaths:     v.importPaths,
 	}
 }
 
@@ -146,6 +154,10 @@ func (v *VM) executor(cfg *executor.Config) *executor.Executor {
 		cfgToUse.DefineImport = utils.Override(cfgToUse.DefineImport, cfg.DefineImport)
 		cfgToUse.DbgEnabled = utils.Override(cfgToUse.DbgEnabled, cfg.DbgEnabled)
 		cfgToUse.ResetEnv = utils.Override(cfgToUse.ResetEnv, cfg.ResetEnv)
+		cfgToUse.Optimize = utils.Override(cfgToUse.Optimize, cfg.Optimize)
+		if cfg.ImportPaths != nil {
+			cfgToUse.ImportPaths = cfg.ImportPaths
+		}
 	}
 	return executor.NewExecutor(cfgToUse)
 }
@@ -1259,6 +1259,106 @@ func TestArraysAndMaps(t *testing.T) {
 	}
 }
 
+func TestDestructuring(t *testing.T) {
+	_ = os.Setenv("ANKO_DEBUG", "1")
+	tests := []Test{
+		{Script: `[a, b] = [1, 2]`, RunOutput: []any{int64(1), int64(2)}, Output: map[string]any{"a": int64(1), "b": int64(2)}},
+		{Script: `[a, b, ...rest] = [1, 2, 3, 4]; rest`, RunOutput: []any{int64(3), int64(4)}, Output: map[string]any{"a": int64(1), "b": int64(2)}},
+		{Script: `[a, ...rest] = [1]; rest`, RunOutput: []any{}},
+		{Script: `[a, b, c] = [1, 2]; c`, RunOutput: nil},
+		{Script: `[a, [b, c]] = [1, [2, 3]]; a + b + c`, RunOutput: int64(6)},
+		{Script: `a = 1; b = 2; [a, b] = [b, a]; [a, b]`, RunOutput: []any{int64(2), int64(1)}},
+		{Script: `m = {}; [m.a, m["b"]] = [1, 2]; m`, RunOutput: map[any]any{"a": int64(1), "b": int64(2)}},
+		{Script: `{name, age} = {"name": "bob", "age": 3}; name + age`, RunOutput: "bob3"},
+		{Script: `{"name": n, "x": x} = {"name": "bob"}; [n, x]`, RunOutput: []any{"bob", nil}},
+		{Script: `{a, "b": [c, d]} = {"a": 1, "b": [2, 3]}; a + c + d`, RunOutput: int64(6)},
+		{Script: `{Name, Age} = p; Name + Age`, Input: map[string]any{"p": struct {
+			Name string
+			Age  int64
+		}{Name: "bob", Age: 3}}, RunOutput: "bob3"},
+		{Script: `{Name} = p; Name`, Input: map[string]any{"p": &struct{ Name string }{Name: "bob"}}, RunOutput: "bob"},
+		{Script: `[a, b] := [1, 2]; a + b`, RunOutput: int64(3)},
+		{Script: `[a, b] := [1, 2]; a = 3`, RunError: fmt.Errorf("immutable variable")},
+		{Script: `mut {a} := {"a": 1}; a = 2; a`, RunOutput: int64(2)},
+		{Script: `mut {a} := {"a": 1}; a = "x"`, RunError: fmt.Errorf("type mismatch")},
+		{Script: `a = 1; [a] := [2]`, RunError: fmt.Errorf("already defined symbol 'a'")},
+		{Script: `var [a, ...b] = [1, 2, 3]; b`, RunOutput: []any{int64(2), int64(3)}, Output: map[string]any{"a": int64(1)}},
+		{Script: `var {a} = {"a": 1}; a`, RunOutput: int64(1)},
+		{Script: `func f([a, b], {c}) { return a + b + c }; f([1, 2], {"c": 3})`, RunOutput: int64(6)},
+		{Script: `func f(a, [b, ...c]...) { return [a, b, c] }; f(1, 2, 3, 4)`, RunOutput: []any{int64(1), int64(2), []any{int64(3), int64(4)}}},
+		{Script: `func f([a, b]) { return a }; f(1)`, RunError: fmt.Errorf("cannot destructure int64 into a list pattern")},
+		{Script: `s = 0; for [k, v] in [[1, 2], [3, 4]] { s += k * v }; s`, RunOutput: int64(14)},
+		{Script: `s = ""; for {name} in [{"name": "a"}, {"name": "b"}] { s += name }; s`, RunOutput: "ab"},
+		{Script: `s = 0; for [a, ...b] in [[1, 2, 3]] { s = a + len(b) }; s`, RunOutput: int64(3)},
+		{Script: `for [a] in [1] { }`, RunError: fmt.Errorf("cannot destructure int64 into a list pattern")},
+		{Script: `[a] = 1`, RunError: fmt.Errorf("cannot destructure int64 into a list pattern")},
+		{Script: `{a} = nil`, RunError: fmt.Errorf("cannot destructure nil into a map pattern")},
+		{Script: `{a} = p; a`, Input: map[string]any{"p": struct{ A int64 }{}}, RunOutput: nil},
+		{Script: `[a + 1] = [1]`, RunError: fmt.Errorf("invalid operation")},
+		{Script: `[a + 1] := [1]`, ParseError: fmt.Errorf("invalid destructuring pattern")},
+		{Script: `[...a, b] = [1]`, ParseError: fmt.Errorf("invalid destructuring pattern")},
+		{Script: `{a, ...rest} = {"a": 1, "b": 2}; rest`, RunOutput: map[any]any{"b": int64(2)}, Output: map[string]any{"a": int64(1)}},
+		{Script: `{Name, ...rest} = p; rest`, Input: map[string]any{"p": struct {
+			Name string
+			Age  int64
+			id   int64
+		}{Name: "bob", Age: 3}}, RunOutput: map[any]any{"Age": int64(3)}},
+		{Script: `var {...all} = {"a": 1}; all`, RunOutput: map[any]any{"a": int64(1)}},
+		{Script: `{...rest, a} = {}`, ParseError: fmt.Errorf("invalid destructuring pattern")},
+		{Script: `var [a.b] = [1]`, ParseError: fmt.Errorf("invalid destructuring pattern")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) { runTest(t, tt, nil) })
+	}
+}
+
+func TestSpread(t *testing.T) {
+	_ = os.Setenv("ANKO_DEBUG", "1")
+	tests := []Test{
+		{Script: `a = [1, 2]; b = [5]; [...a, 4, ...b]`, RunOutput: []any{int64(1), int64(2), int64(4), int64(5)}},
+		{Script: `[...[], ...nil, 1]`, RunOutput: []any{int64(1)}},
+		{Script: `[...a]`, Input: map[string]any{"a": []string{"x", "y"}}, RunOutput: []any{"x", "y"}},
+		{Script: `a = [1, 2]; []int64{...a, 3}`, RunOutput: []int64{1, 2, 3}},
+		{Script: `a = ["x"]; []int64{...a}`, RunError: fmt.Errorf("cannot use type string as type int64 as slice value")},
+		{Script: `[...1]`, RunError: fmt.Errorf("cannot spread type int64 into a list")},
+		{Script: `d = {"x": 1, "y": 2}; {...d, "y": 3, "z": 4}`, RunOutput: map[any]any{"x": int64(1), "y": int64(3), "z": int64(4)}},
+		{Script: `d = {"x": 1}; {"x": 2, ...d}`, RunOutput: map[any]any{"x": int64(1)}},
+		{Script: `{...a, ...nil}`, Input: map[string]any{"a": map[string]int64{"x": 1}}, RunOutput: map[any]any{"x": int64(1)}},
+		{Script: `{...1}`, RunError: fmt.Errorf("cannot spread type int64 into a map")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) { runTest(t, tt, nil) })
+	}
+}
+
+func TestComprehensions(t *testing.T) {
+	_ = os.Setenv("ANKO_DEBUG", "1")
+	tests := []Test{
+		{Script: `items = [1, 5, 2, 8]; [x * 2 for x in items if x > 3]`, RunOutput: []int64{10, 16}},
+		{Script: `[x for x in a]`, Input: map[string]any{"a": []string{"x", "y"}}, RunOutput: []string{"x", "y"}},
+		{Script: `[x for x in [1, "a", nil]]`, RunOutput: []any{int64(1), "a", nil}},
+		{Script: `[x for x in []]`, RunOutput: []any{}},
+		{Script: `[x for x in [1, 2] if x > 2]`, RunOutput: []any{}},
+		{Script: `[a + b for [a, b] in [[1, 2], [3, 4]]]`, RunOutput: []int64{3, 7}},
+		{Script: `[name for {name} in people]`, Input: map[string]any{"people": []any{map[string]any{"name": "Bob"}}}, RunOutput: []string{"Bob"}},
+		{Script: `[[y for y in x] for x in [[1], [2, 3]]]`, RunOutput: [][]int64{{1}, {2, 3}}},
+		{Script: `x = 1; [x for x in [2, 3]]; x`, RunOutput: int64(1)},
+		{Script: `m = {"a": 1, "b": 2}; {k: v * 10 for k, v in m}`, RunOutput: map[string]int64{"a": 10, "b": 20}},
+		{Script: `{x: x > 1 for x in [1, 2]}`, RunOutput: map[int64]bool{1: false, 2: true}},
+		{Script: `{x: x for x in [1, "a"] if x != 1}`, RunOutput: map[string]string{"a": "a"}},
+		{Script: `{x: 1 for x in []}`, RunOutput: map[any]any{}},
+		{Script: `{[x]: 1 for x in [1]}`, RunError: fmt.Errorf("cannot use type []interface {} as map key")},
+		{Script: `func g() { yield 1; yield 2 }; [v * 3 for v in g()]`, RunOutput: []int64{3, 6}},
+		{Script: `c = make(chan int64, 2); c <- 1; c <- 2; close(c); [v for v in c]`, RunOutput: []int64{1, 2}},
+		{Script: `n = 1; [x for x in n]`, RunError: fmt.Errorf("for cannot loop over type int64")},
+		{Script: `[x.y for x in [1]]`, RunError: fmt.Errorf("type int64 does not support member operation")},
+		{Script: `[x for x in [1] if y]`, RunError: fmt.Errorf("undefined symbol 'y'")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) { runTest(t, tt, nil) })
+	}
+}
+
 func TestMakeArraysAndMaps(t *testing.T) {
 	_ = os.Setenv("ANKO_DEBUG", "1")
 	tests := []Test{
@@ -1686,6 +1786,61 @@ func TestStructs(t *testing.T) {
 	}
 }
 
+type testPoint struct {
+	X int64
+	Y int64
+}
+
+func TestScriptStructs(t *testing.T) {
+	_ = os.Setenv("ANKO_DEBUG", "1")
+	pointDecl := "type Point struct { X int64; Y int64 }\n"
+	tests := []Test{
+		{Script: pointDecl + `p = Point{X: 1, Y: 2}; p.X + p.Y`, RunOutput: int64(3)},
+		{Script: pointDecl + `p = Point{Y: 2}; p.X`, RunOutput: int64(0)},
+		{Script: pointDecl + `p = Point{1, 2}; p.Y`, RunOutput: int64(2)},
+		{Script: pointDecl + `p = Point{}; p.Y = 3; p.Y`, RunOutput: int64(3)},
+		{Script: pointDecl + `Point{X: 1.5}.X`, RunOutput: int64(1)},
+		{Script: pointDecl + `Point{1}`, RunError: fmt.Errorf("invalid number of values in struct literal, have 1, expected: 2")},
+		{Script: pointDecl + `Point{Z: 1}`, RunError: fmt.Errorf("no member named 'Z' for struct")},
+		{Script: pointDecl + `Point{X: "a"}`, RunError: fmt.Errorf("cannot use type string as type int64 as struct value")},
+		{Script: pointDecl + `Point{X: 1}.Z`, RunError: fmt.Errorf("no member named 'Z' for struct")},
+		{Script: `Foo{X: 1}`, RunError: fmt.Errorf("undefined type 'Foo'")},
+		{Script: `type Foo []int64; Foo{}`, RunError: fmt.Errorf("invalid struct literal type []int64")},
+		{Script: `type foo struct { x int64 }`, RunError: fmt.Errorf("struct field 'x' must be exported")},
+		{Script: `type foo struct { X int64; X int64 }`, RunError: fmt.Errorf("duplicate struct field 'X'")},
+		{Script: `make(struct { X int64, X string })`, RunError: fmt.Errorf("duplicate struct field 'X'")},
+		{Script: "type Point struct {\n\tX int64\n\tY int64\n}\nPoint{X: 1, Y: 2}.Y", RunOutput: int64(2)},
+		{Script: "type Empty struct {}\nEmpty{}", RunOutput: struct{}{}},
+		{Script: "type Line struct { From Point }", RunError: fmt.Errorf("undefined type 'Point'")},
+		{Script: pointDecl + "type Line struct { From Point; To Point }\nl = Line{To: Point{3, 4}}; l.To.Y", RunOutput: int64(4)},
+		{Script: pointDecl + `type Other struct { X int64; Y int64 }; kindOf(Point{}) == kindOf(Other{})`, RunOutput: true},
+		{Script: pointDecl + `type Other struct { X int64; Y int64 }; typeOf(Point{}) == typeOf(Other{})`, RunOutput: false},
+		{Script: `type Ints []int64; a = make(Ints); len(a)`, RunOutput: int64(0)},
+
+		{Script: pointDecl + `func (p Point) Sum() { return p.X + p.Y }; Point{1, 2}.Sum()`, RunOutput: int64(3)},
+		{Script: pointDecl + `func (p Point) Add(n) { return p.X + n }; p = Point{1, 2}; p.Add(10)`, RunOutput: int64(11)},
+		{Script: pointDecl + `func (p Point) Sum(a ...) { return p.X + len(a) }; Point{1, 2}.Sum(1, 2, 3)`, RunOutput: int64(4)},
+		{Script: pointDecl + `func (p Point) Set(x) { p.X = x }; p = Point{1, 2}; p.Set(5); p.X`, RunOutput: int64(1)},
+		{Script: pointDecl + `func (p Point) Moved(x) { p.X = x; return p.X }; p = Point{1, 2}; [p.Moved(5), p.X]`, RunOutput: []any{int64(5), int64(1)}},
+		{Script: pointDecl + `func (p *Point) Set(x) { p.X = x }; p = new(Point); p.Set(5); p.X`, RunOutput: int64(5)},
+		{Script: pointDecl + `func (p *Point) Set(x) { p.X = x; return p }; Point{1, 2}.Set(5).X`, RunOutput: int64(5)},
+		{Script: pointDecl + `func (p Point) X() { return 1 }; Point{2, 2}.X`, RunOutput: int64(2)},
+		{Script: pointDecl + `func (p Point) Sum() int64 { return p.X + p.Y }; Point{1, 2}.Sum()`, RunOutput: int64(3)},
+		{Script: `func (p int64) Foo() {}`, RunError: fmt.Errorf("invalid receiver type int64")},
+		{Script: `func (p Foo) Foo() {}`, RunError: fmt.Errorf("undefined type 'Foo'")},
+		{Script: pointDecl + `func() { func (p Point) Sum() { return 1 } }(); Point{}.Sum()`, RunError: fmt.Errorf("no member named 'Sum' for struct")},
+		{Script: `func (p) Foo() {}`, ParseError: fmt.Errorf("invalid method receiver")},
+		{Script: `func (a Foo, b Foo) Foo() {}`, ParseError: fmt.Errorf("invalid method receiver")},
+		{Script: `func Bar(a Foo) Foo() {}`, ParseError: fmt.Errorf("invalid method receiver")},
+
+		{Script: pointDecl + `sum(Point{1, 2})`, Input: map[string]any{"sum": func(p testPoint) int64 { return p.X + p.Y }}, RunOutput: int64(3)},
+		{Script: pointDecl + `p = Point{1, 2}; move(&p); p.X`, Input: map[string]any{"move": func(p *testPoint) { p.X = 5 }}, RunOutput: int64(5)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) { runTest(t, tt, &Options{ImportCore: true}) })
+	}
+}
+
 func TestMakeStructs(t *testing.T) {
 	_ = os.Setenv("ANKO_DEBUG", "1")
 
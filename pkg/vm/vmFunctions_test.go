@@ -3,11 +3,13 @@ package vm
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	envPkg "github.com/alaingilbert/anko/pkg/vm/env"
 	"github.com/alaingilbert/anko/pkg/vm/runner"
 	"os"
 	"reflect"
+	"runtime"
 	"testing"
 	"time"
 )
@@ -197,21 +199,21 @@ func TestFunctions(t *testing.T) {
 		// TOFIX:
 		// {Script: `a()`, Input: map[string]any{"a": testVarFuncP}, RunOutput: int64(1), Output: map[string]any{"a": testVarFuncP}, Name: ""},
 
-		{Script: `module a { func b() { return } }; a.b()`, RunOutput: nil, Name: ""},
-		{Script: `module a { func b() { return nil} }; a.b()`, RunOutput: nil, Name: ""},
-		{Script: `module a { func b() { return true} }; a.b()`, RunOutput: true, Name: ""},
-		{Script: `module a { func b() { return 1} }; a.b()`, RunOutput: int64(1), Name: ""},
-		{Script: `module a { func b() { return 1.1} }; a.b()`, RunOutput: float64(1.1), Name: ""},
-		{Script: `module a { func b() { return "a"} }; a.b()`, RunOutput: "a", Name: ""},
+		{Script: `module a { export func b() { return } }; a.b()`, RunOutput: nil, Name: ""},
+		{Script: `module a { export func b() { return nil} }; a.b()`, RunOutput: nil, Name: ""},
+		{Script: `module a { export func b() { return true} }; a.b()`, RunOutput: true, Name: ""},
+		{Script: `module a { export func b() { return 1} }; a.b()`, RunOutput: int64(1), Name: ""},
+		{Script: `module a { export func b() { return 1.1} }; a.b()`, RunOutput: float64(1.1), Name: ""},
+		{Script: `module a { export func b() { return "a"} }; a.b()`, RunOutput: "a", Name: ""},
 
-		{Script: `if true { module a { func b() { return } } }; a.b()`, RunOutput: nil, Name: ""},
-		{Script: `if true { module a { func b() { return nil} } }; a.b()`, RunOutput: nil, Name: ""},
-		{Script: `if true { module a { func b() { return true} } }; a.b()`, RunOutput: true, Name: ""},
-		{Script: `if true { module a { func b() { return 1} } }; a.b()`, RunOutput: int64(1), Name: ""},
-		{Script: `if true { module a { func b() { return 1.1} } }; a.b()`, RunOutput: float64(1.1), Name: ""},
-		{Script: `if true { module a { func b() { return "a"} } }; a.b()`, RunOutput: "a", Name: ""},
+		{Script: `if true { module a { export func b() { return } } }; a.b()`, RunOutput: nil, Name: ""},
+		{Script: `if true { module a { export func b() { return nil} } }; a.b()`, RunOutput: nil, Name: ""},
+		{Script: `if true { module a { export func b() { return true} } }; a.b()`, RunOutput: true, Name: ""},
+		{Script: `if true { module a { export func b() { return 1} } }; a.b()`, RunOutput: int64(1), Name: ""},
+		{Script: `if true { module a { export func b() { return 1.1} } }; a.b()`, RunOutput: float64(1.1), Name: ""},
+		{Script: `if true { module a { export func b() { return "a"} } }; a.b()`, RunOutput: "a", Name: ""},
 
-		{Script: `if true { module a { func b() { return 1} } }; a.b()`, RunOutput: int64(1), Name: ""},
+		{Script: `if true { module a { export func b() { return 1} } }; a.b()`, RunOutput: int64(1), Name: ""},
 
 		{Script: `a = 1; func b() { a = 2 }; b()`, RunOutput: int64(2), Output: map[string]any{"a": int64(2)}, Name: ""},
 		{Script: `b(a); a`, Input: map[string]any{"a": int64(1), "b": func(c any) { c = int64(2); _ = c }}, RunOutput: int64(1), Output: map[string]any{"a": int64(1)}, Name: ""},
@@ -803,6 +805,24 @@ func TestCallFunctionWithVararg(t *testing.T) {
 	}
 }
 
+func TestCallFunctionWithSpread(t *testing.T) {
+	_ = os.Setenv("ANKO_DEBUG", "1")
+	tests := []Test{
+		{Script: `func f(a, b, c) { return [a, b, c] }; x = [1, 2]; f(...x, 3)`, RunOutput: []any{int64(1), int64(2), int64(3)}},
+		{Script: `func f(a, b, c) { return [a, b, c] }; f(1, ...[2], ...[3])`, RunOutput: []any{int64(1), int64(2), int64(3)}},
+		{Script: `func f(a...) { return a }; f(...[1], 2, ...[3, 4])`, RunOutput: []any{int64(1), int64(2), int64(3), int64(4)}},
+		{Script: `func f(a...) { return a }; f(...[1], [2, 3]...)`, RunOutput: []any{int64(1), int64(2), int64(3)}},
+		{Script: `func f(a, b) { return a + b }; f(...[1])`, RunError: fmt.Errorf("function wants 2 arguments but received 1")},
+		{Script: `X(...a, "c")`, Input: map[string]any{"a": []string{"a", "b"}, "X": func(args ...string) []string { return args }}, RunOutput: []string{"a", "b", "c"}},
+		{Script: `a = []; func add(x, y) { a += x + y }; func g() { defer add(...[1, 2]) }; g(); a`, RunOutput: []any{int64(3)}},
+		{Script: `f(...1)`, Input: map[string]any{"f": func(int64) {}}, RunError: fmt.Errorf("cannot spread type int64 into a list")},
+		{Script: `f(...a...)`, ParseError: fmt.Errorf("unexpected VARARG")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) { runTest(t, tt, nil) })
+	}
+}
+
 func TestDeferFunction(t *testing.T) {
 	_ = os.Setenv("ANKO_DEBUG", "1")
 	tests := []Test{
@@ -838,3 +858,99 @@ aFunc()`,
 		t.Run(tt.Name, func(t *testing.T) { runTest(t, tt, nil) })
 	}
 }
+
+func TestGenerators(t *testing.T) {
+	_ = os.Setenv("ANKO_DEBUG", "1")
+	tests := []Test{
+		{Script: `func count(n) { for i = 0; i < n; i++ { yield i } }; a = []; for x in count(3) { a += x }`, RunOutput: nil, Output: map[string]any{"a": []any{int64(0), int64(1), int64(2)}}},
+		{Script: `func count(n) { for i = 0; i < n; i++ { yield i } }; a = []; for x in count(4) { if x == 1 { continue }; if x == 3 { break }; a += x }`, RunOutput: nil, Output: map[string]any{"a": []any{int64(0), int64(2)}}},
+		{Script: `func nat() { i = 0; for { yield i; i++ } }; func find() { for x in nat() { if x == 5 { return x * 2 } } }; find()`, RunOutput: int64(10)},
+		{Script: `a = []; func g() { defer func() { a += "defer" }(); try { yield 1; yield 2 } catch { a += "catch" } finally { a += "finally" } }; for x in g() { a += x; break }`, RunOutput: nil, Output: map[string]any{"a": []any{int64(1), "finally", "defer"}}},
+		{Script: `func g() { yield 1; return 5; yield 2 }; a = []; for x in g() { a += x }`, RunOutput: nil, Output: map[string]any{"a": []any{int64(1)}}},
+		{Script: `func g() { f = func() { yield 1 }; yield f }; a = []; for f in g() { for x in f() { a += x } }`, RunOutput: nil, Output: map[string]any{"a": []any{int64(1)}}},
+		{Script: `func g() { yield 1; throw "boom" }; a = nil; try { for x in g() {} } catch e { a = e.Error() }`, RunOutput: nil, Output: map[string]any{"a": "boom"}},
+		{Script: `func g() { yield 1 }; for x in g() { throw "body" }`, RunError: fmt.Errorf("body"), RunOutput: "body"},
+		{Script: `func g() { yield 1 }; a = g(); for x in a {}; for x in a {}`, RunError: fmt.Errorf("generator already iterated")},
+		{Script: `yield 1`, RunError: fmt.Errorf("yield outside of a generator")},
+		{Script: `yield = 1; yield += 1; yield`, RunOutput: int64(2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) { runTest(t, tt, nil) })
+	}
+}
+
+func TestAsyncAwait(t *testing.T) {
+	_ = os.Setenv("ANKO_DEBUG", "1")
+	tests := []Test{
+		{Script: `func f(x) { return x * 2 }; t = async f(21); await t`, RunOutput: int64(42)},
+		{Script: `t = async func(a, b) { return a + b }(1, 2); await t`, RunOutput: int64(3)},
+		{Script: `func f(x) { return x * 2 }; t = async f(1); [await t, await t]`, RunOutput: []any{int64(2), int64(2)}},
+		{Script: `func f(x) { return x * 2 }; ts = [async f(x) for x in [1, 2, 3]]; await all(...ts)`, RunOutput: []any{int64(2), int64(4), int64(6)}},
+		{Script: `func f(a...) { return len(a) }; await all(async f(...[1, 2]), async f())`, RunOutput: []any{int64(2), int64(0)}},
+		{Script: `await all()`, RunOutput: []any{}},
+		{Script: `c = make(chan bool); func slow() { <-c; return 1 }; func fast() { return 2 }; await race(async slow(), async fast())`, RunOutput: int64(2)},
+		{Script: `func f() { return 1 }; await async f() timeout 1s`, RunOutput: int64(1)},
+		{Script: `c = make(chan bool); func slow() { <-c }; a = nil; try { await async slow() timeout 10ms } catch e { a = e.Error() }; a`, RunOutput: "timed out"},
+		{Script: `c = make(chan bool); func slow() { <-c }; await all(async slow()) timeout 1000000`, RunError: fmt.Errorf("timed out")},
+		{Script: `func f() { throw "boom" }; await async f()`, RunError: fmt.Errorf("boom")},
+		{Script: `c = make(chan bool); func slow() { <-c }; func f() { throw "boom" }; await all(async slow(), async f())`, RunError: fmt.Errorf("boom")},
+		{Script: `await 1`, RunError: fmt.Errorf("cannot await type int64")},
+		{Script: `await race()`, RunError: fmt.Errorf("cannot await the race of no tasks")},
+		{Script: `func f() {}; await async f() timeout "1s"`, RunError: fmt.Errorf("timeout must be a duration, not string")},
+		{Script: `async g()`, RunError: fmt.Errorf("undefined symbol 'g'")},
+		{Script: `1m30s`, RunOutput: 90 * time.Second},
+		{Script: `timeout = 1; timeout`, RunOutput: int64(1)},
+		{Script: `async = 1; await = async + 1; [async, await]`, RunOutput: []any{int64(1), int64(2)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) { runTest(t, tt, nil) })
+	}
+}
+
+func TestAsyncAwait_Cancel(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := New(nil).Executor(nil).Run(ctx, `c = make(chan bool); func slow() { <-c }; await async slow()`)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("execute error - received %#v - expected: %#v", err, context.DeadlineExceeded)
+	}
+	// the tasks still running when the script is over are canceled
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	_, err = New(nil).Executor(nil).Run(ctx2, `c = make(chan bool); func slow() { <-c }; for i = 0; i < 10; i++ { async slow() }`)
+	if err != nil {
+		t.Errorf("execute error - received %#v - expected: %#v", err, nil)
+	}
+	cancel2() // stops the watchdog of the executor
+	n := runtime.NumGoroutine()
+	for deadline := time.Now().Add(time.Second); n > goroutines && time.Now().Before(deadline); n = runtime.NumGoroutine() {
+		time.Sleep(time.Millisecond)
+	}
+	if n > goroutines {
+		t.Errorf("goroutines - received %d - expected at most %d", n, goroutines)
+	}
+}
+
+func TestGenerators_Cancel(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := New(nil).Executor(nil).Run(ctx, `func nat() { i = 0; for { yield i; i++ } }; for x in nat() {}`)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("execute error - received %#v - expected: %#v", err, context.DeadlineExceeded)
+	}
+	// the generators stopped early do not leave goroutines behind
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	_, err = New(nil).Executor(nil).Run(ctx2, `func nat() { i = 0; for { yield i; i++ } }; for i = 0; i < 100; i++ { for x in nat() { break } }`)
+	if err != nil {
+		t.Errorf("execute error - received %#v - expected: %#v", err, nil)
+	}
+	cancel2() // stops the watchdog of the executor
+	n := runtime.NumGoroutine()
+	for deadline := time.Now().Add(time.Second); n > goroutines && time.Now().Before(deadline); n = runtime.NumGoroutine() {
+		time.Sleep(time.Millisecond)
+	}
+	if n > goroutines {
+		t.Errorf("goroutines - received %d - expected at most %d", n, goroutines)
+	}
+}
@@ -1,11 +1,15 @@
 package vm
 
 import (
+	"context"
 	"fmt"
 	envPkg "github.com/alaingilbert/anko/pkg/vm/env"
+	"github.com/stretchr/testify/assert"
+	"math"
 	"os"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestBasicOperators(t *testing.T) {
@@ -433,6 +437,33 @@ func TestNilCoalescingOperator(t *testing.T) {
 	}
 }
 
+func TestOptionalChaining(t *testing.T) {
+	_ = os.Setenv("ANKO_DEBUG", "1")
+	tests := []Test{
+		{Script: `a = {"b": {"c": 1}}; a?.b?.c`, RunOutput: int64(1)},
+		{Script: `a = {"b": {"c": 1}}; a?.x?.c`, RunOutput: nil},
+		{Script: `a = {"b": {"c": 1}}; a?["b"]?["c"]`, RunOutput: int64(1)},
+		{Script: `a = {"b": {"c": 1}}; a?["x"]?["c"]`, RunOutput: nil},
+		{Script: `a = nil; a?.b.c`, RunOutput: nil, RunError: fmt.Errorf("type invalid does not support member operation")},
+		{Script: `a = nil; a?.b ?? 2`, RunOutput: int64(2)},
+		{Script: `a?.b`, Input: map[string]any{"a": (*struct{ B int64 })(nil)}, RunOutput: nil},
+		{Script: `a?.B`, Input: map[string]any{"a": &struct{ B int64 }{B: 3}}, RunOutput: int64(3)},
+		{Script: `a?.C`, Input: map[string]any{"a": struct{ B int64 }{B: 3}}, RunOutput: nil},
+		{Script: `a.C`, Input: map[string]any{"a": struct{ B int64 }{B: 3}}, RunError: fmt.Errorf("no member named 'C' for struct")},
+		{Script: `a = [1, 2]; a?[1]`, RunOutput: int64(2)},
+		{Script: `a = [1, 2]; a?[5]`, RunOutput: nil},
+		{Script: `a = [1, 2]; a[5]`, RunError: fmt.Errorf("index out of range")},
+		{Script: `a = nil; a?[0]`, RunOutput: nil},
+		{Script: `module m { export a = 1 }; m?.a + (m?.b ?? 2)`, RunOutput: int64(3)},
+		{Script: `a = {}; a?.b = 1`, RunError: fmt.Errorf("cannot assign to an optional chaining expression")},
+		{Script: `a = {}; a?["b"] = 1`, RunError: fmt.Errorf("cannot assign to an optional chaining expression")},
+		{Script: `c = true; c ? [1] : [2]`, RunOutput: []any{int64(1)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) { runTest(t, tt, nil) })
+	}
+}
+
 func TestIf(t *testing.T) {
 	_ = os.Setenv("ANKO_DEBUG", "1")
 	tests := []Test{
@@ -515,6 +546,7 @@ func TestSelect(t *testing.T) {
 		{Script: `a = make(chan int64, 1); b = make(chan int64, 1); select {case <-a: return 1; case <-b: return 2; default: return 3}`, RunOutput: int64(3)},
 		{Script: `a = make(chan int64, 1); b = make(chan int64, 1); a <- 1; select {case <-a: return 1; case <-b: return 2; default: return 3}`, RunOutput: int64(1)},
 		{Script: `a = make(chan int64, 1); b = make(chan int64, 1); b <- 1; select {case <-a: return 1; case <-b: return 2; default: return 3}`, RunOutput: int64(2)},
+		{Script: `a = make(chan int64, 1); select {case <-a: return 1; default:}; return 2`, RunOutput: int64(2)},
 
 		// test assignment
 		{Script: `a = make(chan int64, 1); b = make(chan int64, 1); a <- 1; v = 0; select {case v = <-a:; case v = <-b:}; v`, RunOutput: int64(1), Output: map[string]any{"v": int64(1)}},
@@ -605,6 +637,7 @@ func TestSwitch(t *testing.T) {
 		{Script: `a = 2; switch a {case 1,2: return 5; case 3: return 6; default: return 7}`, RunOutput: int64(5), Output: map[string]any{"a": int64(2)}, Name: ""},
 		{Script: `a = 3; switch a {case 1,2: return 5; case 3: return 6; default: return 7}`, RunOutput: int64(6), Output: map[string]any{"a": int64(3)}, Name: ""},
 		{Script: `a = 4; switch a {case 1,2: return 5; case 3: return 6; default: return 7}`, RunOutput: int64(7), Output: map[string]any{"a": int64(4)}, Name: ""},
+		{Script: `a = 4; switch a {case 1,2: return 5; default:}`, RunOutput: nil, Output: map[string]any{"a": int64(4)}},
 
 		// test scope
 		{Script: `a = 1; switch a {case 1: a = 5}`, RunOutput: int64(5), Output: map[string]any{"a": int64(5)}, Name: ""},
@@ -812,6 +845,57 @@ func TestForLoop(t *testing.T) {
 	}
 }
 
+// countSeq returns a range-over-func iterator on the numbers from 0 to n-1, it records the last number produced
+func countSeq(n int64, last *int64) func(yield func(int64) bool) {
+	return func(yield func(int64) bool) {
+		for i := int64(0); i < n; i++ {
+			*last = i
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestForLoopSeq(t *testing.T) {
+	_ = os.Setenv("ANKO_DEBUG", "1")
+	var last int64
+	pairs := func(yield func(string, int64) bool) {
+		_ = yield("a", 1) && yield("b", 2)
+	}
+	tests := []Test{
+		{Script: `a = []; for v in seq { a += v }`, Input: map[string]any{"seq": countSeq(3, &last)}, RunOutput: nil, Output: map[string]any{"a": []any{int64(0), int64(1), int64(2)}}},
+		{Script: `a = []; for v in seq { if v == 1 { continue }; a += v }`, Input: map[string]any{"seq": countSeq(3, &last)}, RunOutput: nil, Output: map[string]any{"a": []any{int64(0), int64(2)}}},
+		{Script: `a = []; for v in seq { if v == 2 { break }; a += v }; a`, Input: map[string]any{"seq": countSeq(1000, &last)}, RunOutput: []any{int64(0), int64(1)}},
+		{Script: `func f() { for v in seq { if v == 4 { return v * 2 } } }; f()`, Input: map[string]any{"seq": countSeq(1000, &last)}, RunOutput: int64(8)},
+		{Script: `a = 0; for v in count(4) { a += v }; a`, Input: map[string]any{"count": func(n int64) func(func(int64) bool) { return countSeq(n, &last) }}, RunOutput: int64(6)},
+		{Script: `a = ""; for k, v in pairs { a += k + v }; a`, Input: map[string]any{"pairs": pairs}, RunOutput: "a1b2"},
+		{Script: `a = ""; for k in pairs { a += k }; a`, Input: map[string]any{"pairs": pairs}, RunOutput: "ab"},
+		{Script: `for v in seq { throw "body" }`, Input: map[string]any{"seq": countSeq(1000, &last)}, RunError: fmt.Errorf("body"), RunOutput: "body"},
+		{Script: `for v in f { }`, Input: map[string]any{"f": func(int64) {}}, RunError: fmt.Errorf("for cannot loop over type func(int64)")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) { runTest(t, tt, nil) })
+	}
+}
+
+func TestForLoopSeq_Stop(t *testing.T) {
+	var last int64
+	v := New(nil)
+	assert.NoError(t, v.Define("seq", countSeq(1000, &last)))
+	_, err := v.Executor(nil).Run(context.Background(), `for v in seq { if v == 2 { break } }`)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), last)
+
+	// each element is a cycle of the script, a stopped script stops the iterator
+	assert.NoError(t, v.Define("seq", countSeq(math.MaxInt64, &last)))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = v.Executor(nil).Run(ctx, `for v in seq { }`)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, last, int64(math.MaxInt64-1))
+}
+
 func TestItemInList(t *testing.T) {
 	_ = os.Setenv("ANKO_DEBUG", "1")
 	tests := []Test{
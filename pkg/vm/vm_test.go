@@ -12,6 +12,7 @@ import (
 	vmUtils "github.com/alaingilbert/anko/pkg/vm/utils"
 	"github.com/stretchr/testify/assert"
 	"io"
+	"io/fs"
 	"os"
 	"reflect"
 	"strings"
@@ -228,6 +229,32 @@ func TestStrings(t *testing.T) {
 	}
 }
 
+func TestStringInterpolation(t *testing.T) {
+	_ = os.Setenv("ANKO_DEBUG", "1")
+	tests := []Test{
+		{Script: `"${a}"`, Input: map[string]any{"a": "x"}, RunOutput: "x"},
+		{Script: `"Hello ${name}, you have ${len(items)} items"`, Input: map[string]any{"name": "Bob", "items": []any{1, 2}}, RunOutput: "Hello Bob, you have 2 items"},
+		{Script: `"${a}${b}"`, Input: map[string]any{"a": int64(1), "b": 1.5}, RunOutput: "11.5"},
+		{Script: `"${a} ${b} ${c}"`, Input: map[string]any{"a": true, "b": nil, "c": []any{1}}, RunOutput: "true nil [1]"},
+		{Script: `"a${1 + 2}b"`, RunOutput: "a3b"},
+		{Script: `m = {"k": "v"}; "${m["k"]}"`, RunOutput: "v"},
+		{Script: `"${"in ${a}"}"`, Input: map[string]any{"a": "x"}, RunOutput: "in x"},
+		{Script: `"${func() { return {"a": 1} }()["a"]}"`, RunOutput: "1"},
+		{Script: `"\${a} $a {a}"`, RunOutput: "${a} $a {a}"},
+		{Script: `'${a}'`, RunOutput: "${a}"},
+		{Script: "`${a}`", RunOutput: "${a}"},
+		{Script: `a = 1; if "${a}" == "1" { a = 2 }; a`, RunOutput: int64(2)},
+		{Script: `"${a}"`, RunError: fmt.Errorf("undefined symbol 'a'"), RunErrorLine: 1, RunErrorColumn: 4},
+		{Script: `"${}"`, ParseError: fmt.Errorf("invalid expression in string interpolation"), RunOutput: ""},
+		{Script: `"${a; b}"`, ParseError: fmt.Errorf("invalid expression in string interpolation"), RunOutput: ""},
+		{Script: `"${a"`, ParseError: fmt.Errorf("unexpected EOF"), RunOutput: ""},
+		{Script: "a = \"${a\na = 1", ParseError: fmt.Errorf("unterminated string interpolation"), RunOutput: int64(1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Script, func(t *testing.T) { runTest(t, tt, nil) })
+	}
+}
+
 func TestVar(t *testing.T) {
 	_ = os.Setenv("ANKO_DEBUG", "1")
 	testInput1 := map[string]any{"b": func() {}}
@@ -416,6 +443,67 @@ a  =  1;
 	}
 }
 
+func TestThrowAndCatch(t *testing.T) {
+	_ = os.Setenv("ANKO_DEBUG", "")
+	open := func(name string) error {
+		return &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	notExistRunErrorFunc := func(t *testing.T, err error) {
+		var vmErr *runner.Error
+		var pathErr *fs.PathError
+		assert.ErrorAs(t, err, &vmErr)
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+		assert.ErrorAs(t, err, &pathErr)
+		assert.Equal(t, "x", pathErr.Path)
+	}
+	thrownRunErrorFunc := func(t *testing.T, err error) {
+		var thrown *runner.ThrownError
+		assert.ErrorAs(t, err, &thrown)
+		assert.Equal(t, int64(404), thrown.Code)
+		assert.Equal(t, "not found", thrown.Message)
+		assert.Equal(t, map[any]any{"id": int64(1)}, thrown.Data)
+	}
+	input := map[string]any{"open": open, "ErrNotExist": fs.ErrNotExist}
+	types := map[string]any{"PathError": fs.PathError{}}
+	tests := []Test{
+		{Script: `throw "a"`, RunError: fmt.Errorf("a"), RunOutput: "a"},
+		{Script: `throw 1`, RunError: fmt.Errorf("1"), RunOutput: int64(1)},
+		{Script: `throw nil`, RunError: fmt.Errorf("<nil>")},
+		{Script: `throw {"code": 404}`, RunError: fmt.Errorf("404"), RunOutput: map[any]any{"code": int64(404)}},
+		{Script: `throw {"code": 404, "message": "not found"}`, RunError: fmt.Errorf("not found"), RunOutput: map[any]any{"code": int64(404), "message": "not found"}},
+		{Script: `throw {"a": 1}`, RunError: fmt.Errorf("map[a:1]"), RunOutput: map[any]any{"a": int64(1)}},
+		{Script: `throw {"code": 404, "message": "not found", "data": {"id": 1}}`, RunErrorFunc: &thrownRunErrorFunc, RunOutput: map[any]any{"code": int64(404), "message": "not found", "data": map[any]any{"id": int64(1)}}},
+		{Script: `throw open("x")`, Input: input, RunErrorFunc: &notExistRunErrorFunc, RunOutput: open("x")},
+		{Script: `try { throw open("x") } catch e { throw e }`, Input: input, RunErrorFunc: &notExistRunErrorFunc},
+
+		{Script: `try { throw "a" } catch e { e.Error() }`, RunOutput: nil},
+		{Script: `a = nil; try { throw "a" } catch e { a = e.Error() }; a`, RunOutput: "a"},
+		{Script: `a = nil; try { throw "a" } catch e { a = e.Code() }; a`, RunOutput: "a"},
+		{Script: `a = nil; try { throw {"code": 404, "data": {"id": 1}} } catch e { a = [e.Code(), e.Data().id] }; a`, RunOutput: []any{int64(404), int64(1)}},
+		{Script: `a = nil; try { throw {"message": "b"} } catch e { a = [e.Code(), e.Data(), e.Error()] }; a`, RunOutput: []any{nil, nil, "b"}},
+		{Script: `a = 1; try { throw open("x") } catch e { a = e.Code() }; a`, Input: input, RunOutput: nil},
+
+		{Script: `a = 0; try { throw "b" } catch e in "a" { a = 1 } catch e in "b", "c" { a = 2 } catch { a = 3 }; a`, RunOutput: int64(2)},
+		{Script: `a = 0; try { throw "d" } catch e in "a" { a = 1 } catch e in "b", "c" { a = 2 } catch { a = 3 }; a`, RunOutput: int64(3)},
+		{Script: `a = 0; try { throw {"code": 404} } catch in 400 { a = 1 } catch in 404 { a = 2 }; a`, RunOutput: int64(2)},
+		{Script: `a = 0; try { throw open("x") } catch e in ErrNotExist { a = errorAs(e, new(PathError)).Path }; a`, Input: input, Types: types, RunOutput: "x"},
+		{Script: `try { throw "b" } catch in "a" { }`, RunError: fmt.Errorf("b")},
+		{Script: `a = 0; try { try { throw "b" } catch in "a" { } finally { a = 1 } } catch { }; a`, RunOutput: int64(1)},
+		{Script: `try { throw "a" } catch e in "a" { throw "b" } catch { }`, RunError: fmt.Errorf("b")},
+		{Script: `try { throw "a" } catch in b { }`, RunError: fmt.Errorf("undefined symbol 'b'")},
+		{Script: `a = 0; try { } catch in b { a = 1 }; a`, RunOutput: int64(0)},
+		{Script: `try { throw "a" } catch in { }`, ParseError: fmt.Errorf("unexpected $end"), RunOutput: nil},
+
+		{Script: `err = open("x"); [errorIs(err, ErrNotExist), errorIs(err, "x"), errorIs(nil, ErrNotExist)]`, Input: input, RunOutput: []any{true, false, false}},
+		{Script: `err = open("x"); errorAs(err, new(PathError)).Op`, Input: input, Types: types, RunOutput: "open"},
+		{Script: `a = nil; try { throw "x" } catch e { a = [errorIs(e, "x"), errorIs(e, "y"), errorAs(e, new(PathError))] }; a`, Types: types, RunOutput: []any{true, false, nil}},
+		{Script: `errorAs(open("x"), 1)`, Input: input, RunError: fmt.Errorf("errorAs target must be an error")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) { runTest(t, tt, &Options{ImportCore: true}) })
+	}
+}
+
 func TestModule(t *testing.T) {
 	_ = os.Setenv("ANKO_DEBUG", "1")
 	tests := []Test{
@@ -423,11 +511,25 @@ func TestModule(t *testing.T) {
 		{Script: `module a { 1++ }`, RunError: fmt.Errorf("invalid operation"), Name: ""},
 		{Script: `module a { }; a.b`, RunError: fmt.Errorf("invalid operation 'b'"), Name: ""},
 
-		{Script: `module a { b = nil }; a.b`, RunOutput: nil, Name: ""},
-		{Script: `module a { b = true }; a.b`, RunOutput: true, Name: ""},
-		{Script: `module a { b = 1 }; a.b`, RunOutput: int64(1), Name: ""},
-		{Script: `module a { b = 1.1 }; a.b`, RunOutput: float64(1.1), Name: ""},
-		{Script: `module a { b = "a" }; a.b`, RunOutput: "a", Name: ""},
+		{Script: `module a { export b = nil }; a.b`, RunOutput: nil, Name: ""},
+		{Script: `module a { export b = true }; a.b`, RunOutput: true, Name: ""},
+		{Script: `module a { export b = 1 }; a.b`, RunOutput: int64(1), Name: ""},
+		{Script: `module a { export b = 1.1 }; a.b`, RunOutput: float64(1.1), Name: ""},
+		{Script: `module a { export b = "a" }; a.b`, RunOutput: "a", Name: ""},
+
+		{Script: `module a { b = 1 }; a.b`, RunError: fmt.Errorf("cannot refer to unexported symbol 'b'"), Name: ""},
+		{Script: `module a { func b() {} }; a.b()`, RunError: fmt.Errorf("cannot refer to unexported symbol 'b'"), Name: ""},
+		{Script: `module a { B = 1 }; a.B`, RunOutput: int64(1), Name: ""},
+		{Script: `module a { func B() { return 1 } }; a.B()`, RunOutput: int64(1), Name: ""},
+		{Script: `module a { b = 1; export func c() { return b } }; a.c()`, RunOutput: int64(1), Name: ""},
+		{Script: `module a { export var b, c = 1, 2 }; a.c`, RunOutput: int64(2), Name: ""},
+		{Script: `module a { export b, c := 1, 2 }; a.b + a.c`, RunOutput: int64(3), Name: ""},
+		{Script: `module a { m = {"k": 1, "l": 2}; export b, c = m["k"] }; a.c`, RunOutput: true, Name: ""},
+		{Script: `module a { if true { export b = 1 } }; a.b`, RunError: fmt.Errorf("invalid operation 'b'"), Name: ""},
+		{Script: `module a { export a.b = 1 }`, ParseError: fmt.Errorf("only symbols can be exported"), RunError: fmt.Errorf("undefined symbol 'a'"), Name: ""},
+		{Script: `module a { export func() {} }`, ParseError: fmt.Errorf("cannot export an anonymous function or a method"), RunOutput: nil, Name: ""},
+		{Script: `export b = 1; b`, RunOutput: int64(1), Name: ""},
+		{Script: `export = 1; export += 2; export`, RunOutput: int64(3), Name: ""},
 	}
 	for _, tt := range tests {
 		t.Run(tt.Name, func(t *testing.T) { runTest(t, tt, nil) })
@@ -862,6 +964,19 @@ for v in a { }
 		`
 closeWaitChan()
 select { }
+`,
+		`
+a = []
+for i = 0; i < 10000; i++ {
+	a += 1
+}
+closeWaitChan()
+b = [[j for j in a] for i in a]
+`,
+		`
+c = make(chan int64)
+closeWaitChan()
+b = {v: 1 for v in c}
 `,
 	}
 	for _, script := range scripts {
@@ -1642,8 +1757,8 @@ func TestEnvRef(t *testing.T) {
 		{Script: `func(x){for a = 1; a < 2; a++ { return func(){return x}}}(1)()`, RunOutput: int64(1), Name: ""},
 		{Script: `func(x){for a in [1] { return func(){return x}}}(1)()`, RunOutput: int64(1), Name: ""},
 		{Script: `func(x){for { return func(){return x}}}(1)()`, RunOutput: int64(1), Name: ""},
-		{Script: `module m { f=func(x){return func(){return x}} }; m.f(1)()`, RunOutput: int64(1), Name: ""},
-		{Script: `module m { f=func(x){return func(){return x}}(1)() }; m.f`, RunOutput: int64(1), Name: ""},
+		{Script: `module m { export f=func(x){return func(){return x}} }; m.f(1)()`, RunOutput: int64(1), Name: ""},
+		{Script: `module m { export f=func(x){return func(){return x}}(1)() }; m.f`, RunOutput: int64(1), Name: ""},
 	}
 	for _, tt := range tests {
 		t.Run(tt.Name, func(t *testing.T) { runTest(t, tt, nil) })
@@ -1863,6 +1978,65 @@ func TestDefineCtx(t *testing.T) {
 	}
 }
 
+func TestWithStmt(t *testing.T) {
+	_ = os.Setenv("ANKO_DEBUG", "1")
+	v := New(&Config{ImportCore: utils.Ptr(true)})
+	// the host functions defined with DefineCtx are given the context of the with statement
+	_ = v.DefineCtx("wait", func(ctx context.Context) error { <-ctx.Done(); return ctx.Err() })
+	e := v.Executor(nil)
+	tests := []Test{
+		{Script: `with timeout(1s) { a = 1 }; a`, RunError: fmt.Errorf("undefined symbol 'a'")},
+		{Script: `a = 0; with timeout(1s) { a = 1 }; a`, RunOutput: int64(1)},
+		{Script: `with timeout(10ms) { wait() }`, RunError: fmt.Errorf("timed out")},
+		{Script: `a = nil; try { with timeout(10ms) { for {} } } catch e in ErrTimeout { a = e.Error() }; a`, RunOutput: "timed out"},
+		{Script: `c = make(chan bool); a = nil; try { with timeout(10ms) { <-c } } catch e { a = errorIs(e, ErrTimeout) }; a`, RunOutput: true},
+		{Script: `c = make(chan bool, 1); c <- true; a = nil; try { with cancel(c) { wait() } } catch e in ErrCanceled { a = e.Error() }; a`, RunOutput: "canceled"},
+		{Script: `c = make(chan bool); close(c); with cancel(c) { wait() }`, RunError: fmt.Errorf("canceled")},
+		{Script: `c = make(chan bool); with cancel(c) { a = 1 }`, RunOutput: int64(1)},
+		{Script: `func f() { with timeout(1s) { return 5 } }; f()`, RunOutput: int64(5)},
+		{Script: `func g() { for i = 0; i < 2; i++ { with timeout(1s) { yield i } } }; [x for x in g()]`, RunOutput: []int64{0, 1}},
+		{Script: `func f() { with timeout(10ms) { wait() } }; t = async f(); await t`, RunError: fmt.Errorf("timed out")},
+		{Script: `with timeout("1s") {}`, RunError: fmt.Errorf("timeout must be a duration, not string")},
+		{Script: `with cancel(1) {}`, RunError: fmt.Errorf("cancel needs a channel, not int64")},
+		{Script: `with timeout(1s) { throw "a" }`, RunError: fmt.Errorf("a"), RunOutput: "a"},
+		{Script: `with = 1; with`, RunOutput: int64(1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) { runTest(t, tt, &Options{Executor: e}) })
+	}
+}
+
+func TestEnums(t *testing.T) {
+	_ = os.Setenv("ANKO_DEBUG", "1")
+	color := vmUtils.NewEnum("Color", []string{"Red", "Green", "Blue"})
+	green, _ := color.Value("Green")
+	tests := []Test{
+		{Script: `enum Color { Red, Green, Blue }; Color.Green`, RunOutput: green},
+		{Script: `enum Color {
+	Red,
+	Green,
+}; c = Color.Red; [c == Color.Red, c == Color.Green, c == "Red", c == 0]`, RunOutput: []any{true, false, false, false}},
+		{Script: `enum A { X }; enum B { X }; A.X == B.X`, RunOutput: false},
+		{Script: `enum Color { Red, Green }; m = {Color.Red: 1, Color.Green: 2}; m[Color.Green]`, RunOutput: int64(2)},
+		{Script: `enum Color { Red, Green }; "${Color.Green}"`, RunOutput: "Green"},
+		{Script: `enum Color { Red, Green }; c = Color.Green; switch c { case Color.Red: 1; case Color.Green: 2 }`, RunOutput: int64(2)},
+		{Script: `enum Color { Red }; Color?.Green`, RunOutput: nil},
+		{Script: `enum Color { Red }; Color.Green`, RunError: fmt.Errorf("enum Color has no value Green")},
+		{Script: `enum Color { Red, Green, Red }`, ParseError: fmt.Errorf("enum Color declares Red twice")},
+		{Script: `enum = 1; enum`, RunOutput: int64(1)},
+		{Script: `enum Color { Red }; Color.Red + 1`, RunError: fmt.Errorf("operator + is not defined on enum values")},
+		{Script: `enum Color { Red }; 1 * Color.Red`, RunError: fmt.Errorf("operator * is not defined on enum values")},
+		{Script: `enum Color { Red }; Color.Red < Color.Red`, RunError: fmt.Errorf("operator < is not defined on enum values")},
+		{Script: `enum Color { Red }; -Color.Red`, RunError: fmt.Errorf("operator - is not defined on enum values")},
+		{Script: `enum Color { Red }; c = Color.Red; c++`, RunError: fmt.Errorf("operator ++ is not defined on enum values")},
+		{Script: `enum Color { Red }; c = Color.Red; c += 1`, RunError: fmt.Errorf("operator + is not defined on enum values")},
+		{Script: `enum Color { Red }; "color: " + Color.Red`, RunOutput: "color: Red"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) { runTest(t, tt, nil) })
+	}
+}
+
 func TestRateLimitPeriod(t *testing.T) {
 	v := New(&Config{RateLimitPeriod: utils.Ptr(time.Minute)})
 	_ = v.DefineCtx("a", func(context.Context) int64 { return 1 })